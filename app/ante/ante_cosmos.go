@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
+
+	"github.com/outbe/outbe-node/app/decorators"
 )
 
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
@@ -14,9 +16,14 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		ante.NewSetUpContextDecorator(),
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
+		decorators.NewMaxTxGasWantedDecorator(options.MaxTxGasWanted),
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
 		wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister()),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		decorators.GovFilterDecorator(
+			decorators.MsgFilterKeepers{options.MsgFilterKeeper, options.PoAKeeper},
+			decorators.NewMsgWalker(options.Cdc, options.MaxNestedMsgDepth),
+		),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
@@ -28,6 +35,8 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// ThrottleDecorator must be called after the signatures are verified
+		decorators.NewThrottleDecorator(options.ThrottleKeeper, decorators.NewMsgWalker(options.Cdc, options.MaxNestedMsgDepth)),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 	)
@@ -18,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+
+	"github.com/outbe/outbe-node/app/decorators"
 )
 
 // BankKeeper defines the contract needed for supply related APIs (noalias)
@@ -41,7 +43,7 @@ type AccountKeeper interface {
 // HandlerOptions defines the list of module keepers required to run the EVM
 // AnteHandler decorators.
 type HandlerOptions struct {
-	Cdc                    codec.BinaryCodec
+	Cdc                    codec.Codec
 	AccountKeeper          AccountKeeper
 	BankKeeper             BankKeeper
 	FeegrantKeeper         ante.FeegrantKeeper
@@ -54,9 +56,22 @@ type HandlerOptions struct {
 	WasmKeeper            *wasmkeeper.Keeper
 	TXCounterStoreService corestoretypes.KVStoreService
 
+	// MaxTxGasWanted is the most gas a tx entering the mempool may want. Zero
+	// disables the limit. It is local to the node, so it is not enforced in
+	// DeliverTx.
 	MaxTxGasWanted uint64
 	IBCKeeper      *ibckeeper.Keeper
 	CircuitKeeper  *circuitkeeper.Keeper
+
+	MsgFilterKeeper decorators.MsgFilterKeeper
+	// PoAKeeper blocks staking messages from non-admins while
+	// proof-of-authority is enabled.
+	PoAKeeper decorators.MsgFilterKeeper
+	// MaxNestedMsgDepth bounds how deep container messages (authz, group, gov,
+	// ICA) may be nested. Zero uses decorators.DefaultMaxNestedMsgDepth.
+	MaxNestedMsgDepth int
+	// ThrottleKeeper limits the txs and gas of each signer.
+	ThrottleKeeper decorators.ThrottleKeeper
 }
 
 // Validate checks if the keepers are defined
@@ -79,6 +94,15 @@ func (options HandlerOptions) Validate() error {
 	if options.CircuitKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "circuit keeper is required for ante builder")
 	}
+	if options.MsgFilterKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "msgfilter keeper is required for ante builder")
+	}
+	if options.PoAKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "poa keeper is required for ante builder")
+	}
+	if options.ThrottleKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "throttle keeper is required for ante builder")
+	}
 
 	if options.WasmConfig == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "wasm config is required for ante builder")
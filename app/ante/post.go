@@ -0,0 +1,37 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/outbe/outbe-node/app/decorators"
+)
+
+// PostHandlerOptions defines the list of module keepers required to run the
+// post handler decorators.
+type PostHandlerOptions struct {
+	TxFeesKeeper decorators.TxFeesKeeper
+}
+
+// Validate checks if the keepers are defined
+func (options PostHandlerOptions) Validate() error {
+	if options.TxFeesKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "txfees keeper is required for post handler")
+	}
+
+	return nil
+}
+
+// NewPostHandler returns the post handler run after the messages of a tx
+// executed successfully.
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	return sdk.ChainPostDecorators(
+		decorators.NewTxFeesPostDecorator(options.TxFeesKeeper),
+	), nil
+}
@@ -87,7 +87,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -145,16 +144,60 @@ import (
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 
+	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
 	ratelimit "github.com/cosmos/ibc-apps/modules/rate-limiting/v8"
 	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
 
+	"github.com/strangelove-ventures/tokenfactory/x/tokenfactory"
+	tokenfactorykeeper "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	chainante "github.com/outbe/outbe-node/app/ante"
+	"github.com/outbe/outbe-node/app/decorators"
+
+	"github.com/outbe/outbe-node/x/contractquery"
+	contractquerykeeper "github.com/outbe/outbe-node/x/contractquery/keeper"
+	contractquerytypes "github.com/outbe/outbe-node/x/contractquery/types"
+	"github.com/outbe/outbe-node/x/forwarding"
+	forwardingkeeper "github.com/outbe/outbe-node/x/forwarding/keeper"
+	forwardingtypes "github.com/outbe/outbe-node/x/forwarding/types"
+	"github.com/outbe/outbe-node/x/icaauth"
+	icaauthkeeper "github.com/outbe/outbe-node/x/icaauth/keeper"
+	icaauthtypes "github.com/outbe/outbe-node/x/icaauth/types"
+	"github.com/outbe/outbe-node/x/icahostpolicy"
+	icahostpolicykeeper "github.com/outbe/outbe-node/x/icahostpolicy/keeper"
+	icahostpolicytypes "github.com/outbe/outbe-node/x/icahostpolicy/types"
+	"github.com/outbe/outbe-node/x/msgfilter"
+	msgfilterkeeper "github.com/outbe/outbe-node/x/msgfilter/keeper"
+	msgfiltertypes "github.com/outbe/outbe-node/x/msgfilter/types"
+	"github.com/outbe/outbe-node/x/ratepolicy"
+	ratepolicykeeper "github.com/outbe/outbe-node/x/ratepolicy/keeper"
+	ratepolicytypes "github.com/outbe/outbe-node/x/ratepolicy/types"
+
+	"github.com/outbe/outbe-node/wasmbinding"
+	"github.com/outbe/outbe-node/x/globalfee"
+	globalfeekeeper "github.com/outbe/outbe-node/x/globalfee/keeper"
+	globalfeetypes "github.com/outbe/outbe-node/x/globalfee/types"
+	"github.com/outbe/outbe-node/x/poa"
+	poakeeper "github.com/outbe/outbe-node/x/poa/keeper"
+	poatypes "github.com/outbe/outbe-node/x/poa/types"
+	"github.com/outbe/outbe-node/x/throttle"
+	throttlekeeper "github.com/outbe/outbe-node/x/throttle/keeper"
+	throttletypes "github.com/outbe/outbe-node/x/throttle/types"
+	"github.com/outbe/outbe-node/x/tokenhooks"
+	tokenhookskeeper "github.com/outbe/outbe-node/x/tokenhooks/keeper"
+	tokenhookstypes "github.com/outbe/outbe-node/x/tokenhooks/types"
+	"github.com/outbe/outbe-node/x/txfees"
+	txfeeskeeper "github.com/outbe/outbe-node/x/txfees/keeper"
+	txfeestypes "github.com/outbe/outbe-node/x/txfees/types"
 )
 
 const (
@@ -163,26 +206,29 @@ const (
 	Bech32Prefix = "outbe"
 
 	ChainID = "localchain-1"
+
+	// maxNestedMsgDepth bounds the nesting of container messages (authz, group,
+	// gov, ICA) checked by the message filter.
+	maxNestedMsgDepth = decorators.DefaultMaxNestedMsgDepth
 )
 
 var (
-	capabilities = []string{
-		"iterator",
-		"staking",
-		"stargate",
-		"cosmwasm_1_1",
-		"cosmwasm_1_2",
-		"cosmwasm_1_3",
-		"cosmwasm_1_4",
-		"cosmwasm_2_0",
-		"cosmwasm_2_1",
-		"cosmwasm_2_2",
+	// tokenFactoryCapabilities are the optional tokenfactory features enabled
+	// on this chain. Sudo minting is left out so no address can mint arbitrary
+	// factory denoms.
+	tokenFactoryCapabilities = []string{
+		tokenfactorytypes.EnableBurnFrom,
+		tokenfactorytypes.EnableForceTransfer,
+		tokenfactorytypes.EnableSetMetadata,
+		tokenfactorytypes.EnableCommunityPoolFeeFunding,
 	}
 )
 
 func init() {
 	// manually update the power reduction based on the base denom unit (10^18 [evm] or 10^6 [cosmos])
 	sdk.DefaultPowerReduction = math.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(BaseDenomUnit), nil))
+	// the default staking, gov and crisis params are in the bond denom
+	sdk.DefaultBondDenom = BaseDenom
 }
 
 // These constants are derived from the above variables.
@@ -223,10 +269,13 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:            {authtypes.Burner},
 	nft.ModuleName:                 nil,
 	// non sdk modules
-	ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-	ibcfeetypes.ModuleName:      nil,
-	icatypes.ModuleName:         nil,
-	wasmtypes.ModuleName:        {authtypes.Burner},
+	ibctransfertypes.ModuleName:  {authtypes.Minter, authtypes.Burner},
+	ibcfeetypes.ModuleName:       nil,
+	icatypes.ModuleName:          nil,
+	wasmtypes.ModuleName:         {authtypes.Burner},
+	tokenfactorytypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+	poatypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+	forwardingtypes.ModuleName:   {authtypes.Burner},
 }
 
 var (
@@ -278,6 +327,20 @@ type ChainApp struct {
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	WasmClientKeeper    wasmlckeeper.Keeper
 	RatelimitKeeper     ratelimitkeeper.Keeper
+	IBCHooksKeeper      ibchookskeeper.Keeper
+	HooksICS4Wrapper    ibchooks.ICS4Middleware
+	MsgFilterKeeper     msgfilterkeeper.Keeper
+	TokenFactoryKeeper  tokenfactorykeeper.Keeper
+	TokenHooksKeeper    tokenhookskeeper.Keeper
+	PoAKeeper           poakeeper.Keeper
+	GlobalFeeKeeper     globalfeekeeper.Keeper
+	TxFeesKeeper        txfeeskeeper.Keeper
+	ThrottleKeeper      throttlekeeper.Keeper
+	ICAAuthKeeper       icaauthkeeper.Keeper
+	ICAHostPolicyKeeper icahostpolicykeeper.Keeper
+	RatePolicyKeeper    ratepolicykeeper.Keeper
+	ForwardingKeeper    forwardingkeeper.Keeper
+	ContractQueryKeeper contractquerykeeper.Keeper
 
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
@@ -296,6 +359,13 @@ type ChainApp struct {
 	// module configurator
 	configurator module.Configurator
 	once         sync.Once
+
+	// the node home, holding the files of a streamed genesis
+	homePath string
+
+	// the 08-wasm light client VM and its data dir
+	wasmLightClientVM  *wasmvm.VM
+	wasmLightClientDir string
 }
 
 // NewChainApp returns a reference to an initialized ChainApp.
@@ -386,6 +456,19 @@ func NewChainApp(
 		packetforwardtypes.StoreKey,
 		wasmlctypes.StoreKey,
 		ratelimittypes.StoreKey,
+		ibchookstypes.StoreKey,
+		msgfiltertypes.StoreKey,
+		tokenfactorytypes.StoreKey,
+		tokenhookstypes.StoreKey,
+		poatypes.StoreKey,
+		globalfeetypes.StoreKey,
+		txfeestypes.StoreKey,
+		throttletypes.StoreKey,
+		icaauthtypes.StoreKey,
+		icahostpolicytypes.StoreKey,
+		ratepolicytypes.StoreKey,
+		forwardingtypes.StoreKey,
+		contractquerytypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(
@@ -633,6 +716,18 @@ func NewChainApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec,
+		keys[tokenfactorytypes.StoreKey],
+		maccPerms,
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		tokenFactoryCapabilities,
+		tokenfactorykeeper.DefaultIsSudoAdminFunc,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// IBC Fee Module keeper
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
 		appCodec, keys[ibcfeetypes.StoreKey],
@@ -652,13 +747,24 @@ func NewChainApp(
 		app.IBCFeeKeeper, // ICS4Wrapper
 	)
 
+	// Create the ibc-hooks keeper and ICS4 wrapper. The wasm keeper is only
+	// set further down, the hooks keep a reference to it.
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(keys[ibchookstypes.StoreKey])
+	wasmHooks := ibchooks.NewWasmHooks(&app.IBCHooksKeeper, &app.WasmKeeper, Bech32PrefixAccAddr)
+	app.HooksICS4Wrapper = ibchooks.NewICS4Middleware(
+		app.RatelimitKeeper,
+		wasmHooks,
+	)
+
 	// Create Transfer Keepers
+	// SendPacket of transfers, including those forwarded by PFM, goes through
+	// every middleware of the stack:
+	// transfer.SendPacket -> ibchooks.SendPacket -> ratelimit.SendPacket -> fee.SendPacket -> channel.SendPacket
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.RatelimitKeeper, // ICS4Wrapper
-		//app.IBCFeeKeeper,
+		app.HooksICS4Wrapper, // ICS4Wrapper: ibchooks, ratelimit, then fee
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -674,10 +780,88 @@ func NewChainApp(
 		app.TransferKeeper, // will be zero-value here, reference is set later on with SetTransferKeeper.
 		app.IBCKeeper.ChannelKeeper,
 		app.BankKeeper,
-		app.IBCKeeper.ChannelKeeper,
+		app.IBCFeeKeeper, // ICS4Wrapper: acks of forwarded packets are written through the fee middleware
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	forwardingConfig, err := ReadPacketForwardConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading packet-forward config: %s", err))
+	}
+
+	// keeps the PFM params in its subspace and charges the forward fee on
+	// the transfers PFM sends
+	app.ForwardingKeeper = forwardingkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[forwardingtypes.StoreKey]),
+		logger,
+		app.GetSubspace(packetforwardtypes.ModuleName),
+		forwardingConfig,
+		runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]),
+		app.TransferKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.PacketForwardKeeper.SetTransferKeeper(app.ForwardingKeeper.TransferKeeper(app.TransferKeeper))
+
+	// creates the rate limits of new channels and denoms from templates
+	app.RatePolicyKeeper = ratepolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[ratepolicytypes.StoreKey]),
+		logger,
+		app.RatelimitKeeper,
+		app.TransferKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[msgfiltertypes.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.PoAKeeper = poakeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[poatypes.StoreKey]),
+		logger,
+		app.StakingKeeper,
+		stakingkeeper.NewMsgServerImpl(app.StakingKeeper),
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[globalfeetypes.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.TxFeesKeeper = txfeeskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[txfeestypes.StoreKey]),
+		logger,
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
+
+	app.ThrottleKeeper = throttlekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[throttletypes.StoreKey]),
+		logger,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// messages executed outside of the ante chain (ICA host packets and wasm
+	// dispatches) are subject to the same filter as regular txs
+	msgFilters := decorators.MsgFilterKeepers{app.MsgFilterKeeper, app.PoAKeeper}
+	filteredMsgRouter := decorators.NewFilteredMsgRouter(
+		app.MsgServiceRouter(),
+		decorators.GovFilterDecorator(msgFilters, decorators.NewMsgWalker(appCodec, maxNestedMsgDepth)),
+		appCodec,
+	)
 
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec,
@@ -688,16 +872,44 @@ func NewChainApp(
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		scopedICAHostKeeper,
-		app.MsgServiceRouter(),
+		filteredMsgRouter,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.ICAHostKeeper.WithQueryRouter(app.GRPCQueryRouter())
 
+	// per-connection and per-controller-chain restrictions on top of the
+	// allow_messages param of the ICA host
+	app.ICAHostPolicyKeeper = icahostpolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icahostpolicytypes.StoreKey]),
+		logger,
+		&app.ICAHostKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ConnectionKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// the ICA auth module sits between the controller and the fee middleware,
+	// so that its policy applies to every packet of the accounts it registered
+	app.ICAAuthKeeper = icaauthkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icaauthtypes.StoreKey]),
+		logger,
+		app.IBCFeeKeeper,
+		&app.ICAControllerKeeper,
+		app.GroupKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		&app.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec,
 		keys[icacontrollertypes.StoreKey],
 		app.GetSubspace(icacontrollertypes.SubModuleName),
-		app.IBCFeeKeeper, // use ics29 fee as ics4Wrapper in middleware stack
+		app.ICAAuthKeeper, // ICS4Wrapper: icaauth policy, then ics29 fee
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		scopedICAControllerKeeper,
@@ -705,13 +917,62 @@ func NewChainApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	wasmOpts = append(wasmOpts)
 	wasmDir := filepath.Join(homePath, "data")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
+	wasmVMConfig, err := ReadWasmVMConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm vm config: %s", err))
+	}
+
+	wasmLightClientConfig, err := ReadWasmLightClientConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading wasm light client config: %s", err))
+	}
+
+	anteConfig, err := ReadAnteConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading ante config: %s", err))
+	}
+
+	// contracts can only send the gRPC queries accepted by governance
+	app.ContractQueryKeeper = contractquerykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[contractquerytypes.StoreKey]),
+		logger,
+		app.GRPCQueryRouter(),
+		wasmVMConfig.Capabilities,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Stargate: app.ContractQueryKeeper.StargateQuerier(),
+		Grpc:     app.ContractQueryKeeper.GrpcQuerier(),
+	}))
+
+	// contracts manage factory denoms through the tokenfactory bindings and
+	// read chain native state through the chain bindings, their messages go
+	// through the filtered router like the other contract messages
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(filteredMsgRouter, app.BankKeeper, &app.TokenFactoryKeeper, wasmbinding.ChainKeepers{
+		RateLimit: app.RatelimitKeeper,
+		Circuit:   &app.CircuitKeeper,
+		ICAAuth:   app.ICAAuthKeeper,
+		Params: map[string]wasmbinding.ParamsQuerier{
+			forwardingtypes.ModuleName:    wasmbinding.ParamsOf(app.ForwardingKeeper.GetParams),
+			globalfeetypes.ModuleName:     wasmbinding.ParamsOf(app.GlobalFeeKeeper.GetParams),
+			icaauthtypes.ModuleName:       wasmbinding.ParamsOf(app.ICAAuthKeeper.GetParams),
+			icahostpolicytypes.ModuleName: wasmbinding.ParamsOf(app.ICAHostPolicyKeeper.GetParams),
+			msgfiltertypes.ModuleName:     wasmbinding.ParamsOf(app.MsgFilterKeeper.GetParams),
+			poatypes.ModuleName:           wasmbinding.ParamsOf(app.PoAKeeper.GetParams),
+			ratepolicytypes.ModuleName:    wasmbinding.ParamsOf(app.RatePolicyKeeper.GetParams),
+			throttletypes.ModuleName:      wasmbinding.ParamsOf(app.ThrottleKeeper.GetParams),
+			txfeestypes.ModuleName:        wasmbinding.ParamsOf(app.TxFeesKeeper.GetParams),
+			contractquerytypes.ModuleName: wasmbinding.ParamsOf(app.ContractQueryKeeper.GetParams),
+		},
+	})...)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	app.WasmKeeper = wasmkeeper.NewKeeper(
@@ -726,35 +987,51 @@ func NewChainApp(
 		app.IBCKeeper.PortKeeper,
 		scopedWasmKeeper,
 		app.TransferKeeper,
-		app.MsgServiceRouter(),
+		filteredMsgRouter,
 		app.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
-		capabilities,
+		wasmVMConfig.Capabilities,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
 
+	// before-send hooks let the admin of a factory denom attach a contract that
+	// can reject transfers of that denom
+	app.TokenHooksKeeper = tokenhookskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[tokenhookstypes.StoreKey]),
+		logger,
+		app.AccountKeeper,
+		app.TokenFactoryKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		app.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.BankKeeper.AppendSendRestriction(app.TokenHooksKeeper.BeforeSendRestriction)
+
 	wasmLightClientQuerier := wasmlctypes.QueryPlugins{
 		// Custom: MyCustomQueryPlugin(),
 		// `myAcceptList` is a `[]string` containing the list of gRPC query paths that the chain wants to allow for the `08-wasm` module to query.
 		// These queries must be registered in the chain's gRPC query router, be deterministic, and track their gas usage.
 		// The `AcceptListStargateQuerier` function will return a query plugin that will only allow queries for the paths in the `myAcceptList`.
 		// The query responses are encoded in protobuf unlike the implementation in `x/wasm`.
-		Stargate: wasmlctypes.AcceptListStargateQuerier([]string{
-			"/ibc.core.client.v1.Query/ClientState",
-			"/ibc.core.client.v1.Query/ConsensusState",
-			"/ibc.core.connection.v1.Query/Connection",
-		}),
+		Stargate: wasmlctypes.AcceptListStargateQuerier(wasmLightClientConfig.QueryAllowList),
 	}
 
-	dataDir := filepath.Join(homePath, "data")
-
-	var memCacheSizeMB uint32 = 100
-	lc08, err := wasmvm.NewVM(filepath.Join(dataDir, "08-light-client"), capabilities, 32, false, memCacheSizeMB)
+	app.homePath = homePath
+	app.wasmLightClientDir = wasmLightClientConfig.VMDir(homePath)
+	lc08, err := wasmvm.NewVM(
+		app.wasmLightClientDir,
+		wasmVMConfig.Capabilities,
+		wasmLightClientConfig.InstanceMemoryLimit,
+		wasmLightClientConfig.Debug,
+		wasmLightClientConfig.MemoryCacheSize,
+	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to create VM for 08 light client: %s", err))
 	}
+	app.wasmLightClientVM = lc08
 
 	app.WasmClientKeeper = wasmlckeeper.NewKeeperWithVM(
 		appCodec,
@@ -767,31 +1044,39 @@ func NewChainApp(
 	)
 
 	// Create Transfer Stack
+	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+	// channel.RecvPacket -> fee.OnRecvPacket -> forwarding.OnRecvPacket -> packetforward.OnRecvPacket -> ibchooks.OnRecvPacket -> ratepolicy.OnRecvPacket -> ratelimit.OnRecvPacket -> transfer.OnRecvPacket
+	// ibchooks runs below PFM so that the final hop of a forward can call a
+	// contract, and above the rate limits so that funds reaching a contract
+	// are counted like any other transfer.
+	// forwarding builds PFM with the retries and timeout of its params for
+	// every packet, and settles the forward fee once PFM is done.
+	// The fee middleware is the outermost one, so that it records the relayer of
+	// packets acknowledged asynchronously by PFM and wraps their acks in
+	// incentivized acks like the synchronous ones.
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RatelimitKeeper, transferStack)
+	transferStack = ratepolicy.NewIBCMiddleware(transferStack, app.RatePolicyKeeper)
+	transferStack = ibchooks.NewIBCMiddleware(transferStack, &app.HooksICS4Wrapper)
+	transferStack = forwarding.NewIBCMiddleware(transferStack, app.PacketForwardKeeper, app.ForwardingKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
-	transferStack = packetforward.NewIBCMiddleware(
-		transferStack,
-		app.PacketForwardKeeper,
-		0,
-		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
-	)
 
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// icaauth.SubmitTx -> icaController.SendPacket -> icaauth.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// Acknowledgements and timeouts of accounts registered through icaauth are
+	// routed back to it, and from there to owner contracts.
 	var icaControllerStack porttypes.IBCModule
-	// integration point for custom authentication modules
-	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper)
+	icaControllerStack = icaauth.NewIBCModule(app.ICAAuthKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
-	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
+	// channel.RecvPacket -> fee.OnRecvPacket -> icahostpolicy.OnRecvPacket -> icaHost.OnRecvPacket
 	var icaHostStack porttypes.IBCModule
 	icaHostStack = icahost.NewIBCModule(app.ICAHostKeeper)
+	icaHostStack = icahostpolicy.NewIBCMiddleware(icaHostStack, app.ICAHostPolicyKeeper)
 	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, app.IBCFeeKeeper)
 
 	var wasmStack porttypes.IBCModule // Create fee enabled wasm ibc Stack
@@ -858,6 +1143,19 @@ func NewChainApp(
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		wasmlc.NewAppModule(app.WasmClientKeeper),
 		ratelimit.NewAppModule(appCodec, app.RatelimitKeeper),
+		ibchooks.NewAppModule(app.AccountKeeper),
+		msgfilter.NewAppModule(appCodec, app.MsgFilterKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
+		tokenhooks.NewAppModule(appCodec, app.TokenHooksKeeper),
+		poa.NewAppModule(appCodec, app.PoAKeeper),
+		globalfee.NewAppModule(appCodec, app.GlobalFeeKeeper),
+		txfees.NewAppModule(appCodec, app.TxFeesKeeper),
+		throttle.NewAppModule(appCodec, app.ThrottleKeeper),
+		icaauth.NewAppModule(appCodec, app.ICAAuthKeeper),
+		icahostpolicy.NewAppModule(appCodec, app.ICAHostPolicyKeeper),
+		ratepolicy.NewAppModule(appCodec, app.RatePolicyKeeper),
+		forwarding.NewAppModule(appCodec, app.ForwardingKeeper),
+		contractquery.NewAppModule(appCodec, app.ContractQueryKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -868,6 +1166,7 @@ func NewChainApp(
 		app.ModuleManager,
 		map[string]module.AppModuleBasic{
 			genutiltypes.ModuleName: genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
+			banktypes.ModuleName:    bankModuleBasic{app.ModuleManager.Modules[banktypes.ModuleName].(bank.AppModule)},
 		})
 	app.BasicModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.BasicModuleManager.RegisterInterfaces(interfaceRegistry)
@@ -900,6 +1199,7 @@ func NewChainApp(
 		packetforwardtypes.ModuleName,
 		wasmlctypes.ModuleName,
 		ratelimittypes.ModuleName,
+		throttletypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -959,6 +1259,19 @@ func NewChainApp(
 		packetforwardtypes.ModuleName,
 		wasmlctypes.ModuleName,
 		ratelimittypes.ModuleName,
+		ibchookstypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		tokenfactorytypes.ModuleName,
+		tokenhookstypes.ModuleName, // hooks reference tokenfactory denoms and wasm contracts
+		poatypes.ModuleName,
+		globalfeetypes.ModuleName,
+		txfeestypes.ModuleName,
+		throttletypes.ModuleName,
+		icaauthtypes.ModuleName,
+		icahostpolicytypes.ModuleName,
+		ratepolicytypes.ModuleName,
+		forwardingtypes.ModuleName,
+		contractquerytypes.ModuleName, // every accepted query must be routed
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -1021,7 +1334,13 @@ func NewChainApp(
 		WasmConfig:            &wasmConfig,
 		TXCounterStoreService: runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
 		CircuitKeeper:         &app.CircuitKeeper,
+		MsgFilterKeeper:       app.MsgFilterKeeper,
+		PoAKeeper:             app.PoAKeeper,
+		MaxNestedMsgDepth:     maxNestedMsgDepth,
+		MaxTxGasWanted:        anteConfig.MaxTxGasWanted,
+		ThrottleKeeper:        app.ThrottleKeeper,
 		SigGasConsumer:        authante.DefaultSigVerificationGasConsumer,
+		TxFeeChecker:          decorators.GlobalFeeChecker(app.GlobalFeeKeeper),
 	})
 
 	// must be before Loading version
@@ -1083,7 +1402,7 @@ func NewChainApp(
 			panic(fmt.Sprintf("failed initialize pinned codes %s", err))
 		}
 
-		if err := wasmlckeeper.InitializePinnedCodes(ctx); err != nil {
+		if err := app.initializeLightClientPinnedCodes(ctx); err != nil {
 			panic(fmt.Sprintf("wasmlckeeper failed initialize pinned codes %s", err))
 		}
 	}
@@ -1121,8 +1440,10 @@ func (app *ChainApp) setAnteHandler(options chainante.HandlerOptions) {
 }
 
 func (app *ChainApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler, err := chainante.NewPostHandler(
+		chainante.PostHandlerOptions{
+			TxFeesKeeper: app.TxFeesKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -1163,8 +1484,19 @@ func (app *ChainApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*
 	if err != nil {
 		panic(err)
 	}
-	response, err := app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
-	return response, err
+	var response *abci.ResponseInitChain
+	if _, ok := genesisState[StreamedAppStateKey]; ok {
+		response, err = app.initStreamedGenesis(ctx, genesisState)
+	} else {
+		response, err = app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := app.validateGenesisDenoms(ctx); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // LoadHeight loads a particular height
@@ -1227,6 +1559,11 @@ func (a *ChainApp) DefaultGenesis() map[string]json.RawMessage {
 	mintGenState.Params.MintDenom = BaseDenom
 	genesis[minttypes.ModuleName] = a.appCodec.MustMarshalJSON(mintGenState)
 
+	tokenFactoryGenState := tokenfactorytypes.DefaultGenesis()
+	// charge 10 DisplayDenom per created denom
+	tokenFactoryGenState.Params.DenomCreationFee = sdk.NewCoins(sdk.NewCoin(BaseDenom, sdk.DefaultPowerReduction.MulRaw(10)))
+	genesis[tokenfactorytypes.ModuleName] = a.appCodec.MustMarshalJSON(tokenFactoryGenState)
+
 	return genesis
 }
 
@@ -1370,6 +1707,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -0,0 +1,332 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	forwardingtypes "github.com/outbe/outbe-node/x/forwarding/types"
+)
+
+const (
+	// FlagMaxTxGasWanted is the app.toml key of AnteConfig.MaxTxGasWanted.
+	FlagMaxTxGasWanted = "ante.max-tx-gas-wanted"
+
+	// FlagMaxInFlightPacketsLimit is the app.toml key of the page size cap
+	// of the in-flight packets query of the packet-forward middleware.
+	FlagMaxInFlightPacketsLimit = "packet-forward.max-in-flight-packets-limit"
+
+	// FlagWasmCapabilities is the app.toml key of WasmVMConfig.Capabilities.
+	FlagWasmCapabilities = "wasm-vm.capabilities"
+
+	// FlagWasmLightClientDir is the app.toml key of WasmLightClientConfig.Dir.
+	FlagWasmLightClientDir = "wasm-light-client.dir"
+
+	// FlagWasmLightClientMemoryCacheSize is the app.toml key of
+	// WasmLightClientConfig.MemoryCacheSize.
+	FlagWasmLightClientMemoryCacheSize = "wasm-light-client.memory-cache-size"
+
+	// FlagWasmLightClientInstanceMemoryLimit is the app.toml key of
+	// WasmLightClientConfig.InstanceMemoryLimit.
+	FlagWasmLightClientInstanceMemoryLimit = "wasm-light-client.instance-memory-limit"
+
+	// FlagWasmLightClientDebug is the app.toml key of
+	// WasmLightClientConfig.Debug.
+	FlagWasmLightClientDebug = "wasm-light-client.debug"
+
+	// FlagWasmLightClientQueryAllowList is the app.toml key of
+	// WasmLightClientConfig.QueryAllowList.
+	FlagWasmLightClientQueryAllowList = "wasm-light-client.query-allow-list"
+)
+
+// AnteConfig holds the node local limits of the ante handler, set in the
+// [ante] section of app.toml.
+type AnteConfig struct {
+	// MaxTxGasWanted is the most gas a tx entering the mempool of the node may
+	// want. Zero disables the limit.
+	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+}
+
+// DefaultAnteConfig returns the default ante handler config.
+func DefaultAnteConfig() AnteConfig {
+	return AnteConfig{MaxTxGasWanted: 0}
+}
+
+// AnteConfigTemplate returns the app.toml template of the ante handler config.
+func AnteConfigTemplate() string {
+	return `
+###############################################################################
+###                              Ante Handler                               ###
+###############################################################################
+
+[ante]
+# The most gas a tx entering the mempool of this node may want, 0 for no limit.
+max-tx-gas-wanted = {{ .Ante.MaxTxGasWanted }}
+`
+}
+
+// ReadAnteConfig reads the ante handler config from the app options.
+func ReadAnteConfig(opts servertypes.AppOptions) (AnteConfig, error) {
+	cfg := DefaultAnteConfig()
+
+	if v := opts.Get(FlagMaxTxGasWanted); v != nil {
+		n, err := cast.ToUint64E(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagMaxTxGasWanted, err)
+		}
+		cfg.MaxTxGasWanted = n
+	}
+
+	return cfg, nil
+}
+
+// PacketForwardConfigTemplate returns the app.toml template of the
+// packet-forward middleware node config.
+func PacketForwardConfigTemplate() string {
+	return `
+###############################################################################
+###                          Packet-Forward Middleware                       ###
+###############################################################################
+
+[packet-forward]
+# The retries, the timeout and the fee of forwards are governance params: they
+# end up in the forwarded packets and in the chain state.
+
+# The most in-flight packets a page of the in-flight-packets query of this
+# node returns, 0 for no limit.
+max-in-flight-packets-limit = {{ .PacketForward.MaxInFlightPacketsLimit }}
+`
+}
+
+// ReadPacketForwardConfig reads the packet-forward middleware node config from
+// the app options.
+func ReadPacketForwardConfig(opts servertypes.AppOptions) (forwardingtypes.NodeConfig, error) {
+	cfg := forwardingtypes.DefaultNodeConfig()
+
+	if v := opts.Get(FlagMaxInFlightPacketsLimit); v != nil {
+		n, err := cast.ToUint64E(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagMaxInFlightPacketsLimit, err)
+		}
+		cfg.MaxInFlightPacketsLimit = n
+	}
+
+	return cfg, nil
+}
+
+// WasmVMConfig holds the capabilities of the wasm VMs, set in the [wasm-vm]
+// section of app.toml.
+type WasmVMConfig struct {
+	// Capabilities are the capabilities contracts stored on the chain can
+	// require. Empty uses DefaultCapabilities.
+	Capabilities []string `mapstructure:"capabilities"`
+}
+
+// DefaultWasmVMConfig returns the default wasm VM config, using the chain
+// default capabilities.
+func DefaultWasmVMConfig() WasmVMConfig {
+	return WasmVMConfig{Capabilities: []string{}}
+}
+
+// WasmVMConfigTemplate returns the app.toml template of the wasm VM config.
+func WasmVMConfigTemplate() string {
+	return `
+###############################################################################
+###                                 Wasm VM                                 ###
+###############################################################################
+
+[wasm-vm]
+# The capabilities contracts can require, for the x/wasm and 08-wasm VMs.
+# Empty uses the chain default. Contracts are only stored if the node supports
+# all the capabilities they require, so every node of the chain must use the
+# same set.
+capabilities = [{{ range $i, $c := .WasmVM.Capabilities }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end }}]
+`
+}
+
+// ReadWasmVMConfig reads the wasm VM config from the app options. The
+// capabilities are the chain default ones unless set.
+func ReadWasmVMConfig(opts servertypes.AppOptions) (WasmVMConfig, error) {
+	cfg := DefaultWasmVMConfig()
+
+	if v := opts.Get(FlagWasmCapabilities); v != nil {
+		capabilities, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmCapabilities, err)
+		}
+		cfg.Capabilities = capabilities
+	}
+
+	if len(cfg.Capabilities) == 0 {
+		cfg.Capabilities = DefaultCapabilities()
+	}
+
+	if err := ValidateCapabilities(cfg.Capabilities); err != nil {
+		return cfg, fmt.Errorf("%s: %w", FlagWasmCapabilities, err)
+	}
+
+	return cfg, nil
+}
+
+// WasmLightClientConfig holds the node local settings of the 08-wasm light
+// client VM, set in the [wasm-light-client] section of app.toml.
+type WasmLightClientConfig struct {
+	// Dir is the data dir of the VM, relative to the node home unless
+	// absolute.
+	Dir string `mapstructure:"dir"`
+	// MemoryCacheSize is the size in MiB of the in-memory cache of compiled
+	// light client contracts.
+	MemoryCacheSize uint32 `mapstructure:"memory-cache-size"`
+	// InstanceMemoryLimit is the memory in MiB a light client contract
+	// instance may use.
+	InstanceMemoryLimit uint32 `mapstructure:"instance-memory-limit"`
+	// Debug prints the debug output of light client contracts.
+	Debug bool `mapstructure:"debug"`
+	// QueryAllowList are the gRPC query paths light client contracts may
+	// query. Empty uses DefaultWasmLightClientQueryAllowList.
+	QueryAllowList []string `mapstructure:"query-allow-list"`
+}
+
+// DefaultWasmLightClientQueryAllowList returns the gRPC query paths light
+// client contracts may query when app.toml does not set any.
+func DefaultWasmLightClientQueryAllowList() []string {
+	return []string{
+		"/ibc.core.client.v1.Query/ClientState",
+		"/ibc.core.client.v1.Query/ConsensusState",
+		"/ibc.core.connection.v1.Query/Connection",
+	}
+}
+
+// DefaultWasmLightClientConfig returns the default 08-wasm light client VM
+// config.
+func DefaultWasmLightClientConfig() WasmLightClientConfig {
+	return WasmLightClientConfig{
+		Dir:                 filepath.Join("data", "08-light-client"),
+		MemoryCacheSize:     100,
+		InstanceMemoryLimit: 32,
+		Debug:               false,
+		QueryAllowList:      DefaultWasmLightClientQueryAllowList(),
+	}
+}
+
+// VMDir returns the data dir of the VM of a node with the given home.
+func (c WasmLightClientConfig) VMDir(home string) string {
+	if filepath.IsAbs(c.Dir) {
+		return c.Dir
+	}
+	return filepath.Join(home, c.Dir)
+}
+
+// Validate checks the limits are set and the allow-list only holds gRPC
+// query paths.
+func (c WasmLightClientConfig) Validate() error {
+	if c.Dir == "" {
+		return fmt.Errorf("%s: empty dir", FlagWasmLightClientDir)
+	}
+
+	if c.MemoryCacheSize == 0 {
+		return fmt.Errorf("%s: must be positive", FlagWasmLightClientMemoryCacheSize)
+	}
+
+	if c.InstanceMemoryLimit == 0 {
+		return fmt.Errorf("%s: must be positive", FlagWasmLightClientInstanceMemoryLimit)
+	}
+
+	seen := make(map[string]bool, len(c.QueryAllowList))
+	for _, path := range c.QueryAllowList {
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+			return fmt.Errorf("%s: invalid query path %q, want /<service>/<method>", FlagWasmLightClientQueryAllowList, path)
+		}
+
+		if seen[path] {
+			return fmt.Errorf("%s: duplicate query path %q", FlagWasmLightClientQueryAllowList, path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
+
+// WasmLightClientConfigTemplate returns the app.toml template of the 08-wasm
+// light client VM config.
+func WasmLightClientConfigTemplate() string {
+	return `
+###############################################################################
+###                            Wasm Light Client                            ###
+###############################################################################
+
+[wasm-light-client]
+# The data dir of the 08-wasm light client VM, relative to the node home
+# unless absolute.
+dir = "{{ .WasmLightClient.Dir }}"
+
+# The size in MiB of the in-memory cache of compiled light client contracts.
+memory-cache-size = {{ .WasmLightClient.MemoryCacheSize }}
+
+# The memory in MiB a light client contract instance may use.
+instance-memory-limit = {{ .WasmLightClient.InstanceMemoryLimit }}
+
+# Print the debug output of light client contracts.
+debug = {{ .WasmLightClient.Debug }}
+
+# The gRPC query paths light client contracts may query. They must be
+# deterministic, so every node of the chain must use the same list. Empty uses
+# the chain default.
+query-allow-list = [{{ range $i, $p := .WasmLightClient.QueryAllowList }}{{ if $i }}, {{ end }}"{{ $p }}"{{ end }}]
+`
+}
+
+// ReadWasmLightClientConfig reads the 08-wasm light client VM config from the
+// app options.
+func ReadWasmLightClientConfig(opts servertypes.AppOptions) (WasmLightClientConfig, error) {
+	cfg := DefaultWasmLightClientConfig()
+
+	if v := opts.Get(FlagWasmLightClientDir); v != nil {
+		dir, err := cast.ToStringE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmLightClientDir, err)
+		}
+		cfg.Dir = dir
+	}
+
+	if v := opts.Get(FlagWasmLightClientMemoryCacheSize); v != nil {
+		n, err := cast.ToUint32E(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmLightClientMemoryCacheSize, err)
+		}
+		cfg.MemoryCacheSize = n
+	}
+
+	if v := opts.Get(FlagWasmLightClientInstanceMemoryLimit); v != nil {
+		n, err := cast.ToUint32E(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmLightClientInstanceMemoryLimit, err)
+		}
+		cfg.InstanceMemoryLimit = n
+	}
+
+	if v := opts.Get(FlagWasmLightClientDebug); v != nil {
+		debug, err := cast.ToBoolE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmLightClientDebug, err)
+		}
+		cfg.Debug = debug
+	}
+
+	if v := opts.Get(FlagWasmLightClientQueryAllowList); v != nil {
+		paths, err := cast.ToStringSliceE(v)
+		if err != nil {
+			return cfg, fmt.Errorf("%s: %w", FlagWasmLightClientQueryAllowList, err)
+		}
+		if len(paths) > 0 {
+			cfg.QueryAllowList = paths
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	contractquerytypes "github.com/outbe/outbe-node/x/contractquery/types"
+)
+
+// TestWasmAcceptedQueries checks that every query of the default accept-list
+// is routed and answers the same bytes for the same gas on every run.
+func TestWasmAcceptedQueries(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	require.Equal(t, contractquerytypes.DefaultAcceptedQueries, gapp.ContractQueryKeeper.GetParams(ctx).AcceptedQueries)
+
+	delegations, err := gapp.StakingKeeper.GetAllDelegations(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, delegations)
+	delegator, validator := delegations[0].DelegatorAddress, delegations[0].ValidatorAddress
+
+	bondDenom, err := gapp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	// queries answered with a result, the others may be answered with an error
+	requests := map[string]proto.Message{
+		"/cosmos.auth.v1beta1.Query/Account":       &authtypes.QueryAccountRequest{Address: delegator},
+		"/cosmos.bank.v1beta1.Query/Balance":       &banktypes.QueryBalanceRequest{Address: delegator, Denom: bondDenom},
+		"/cosmos.bank.v1beta1.Query/SupplyOf":      &banktypes.QuerySupplyOfRequest{Denom: bondDenom},
+		"/cosmos.staking.v1beta1.Query/Delegation": &stakingtypes.QueryDelegationRequest{DelegatorAddr: delegator, ValidatorAddr: validator},
+		"/cosmos.staking.v1beta1.Query/Validator":  &stakingtypes.QueryValidatorRequest{ValidatorAddr: validator},
+	}
+
+	stargate := gapp.ContractQueryKeeper.StargateQuerier()
+	for _, q := range contractquerytypes.DefaultAcceptedQueries {
+		t.Run(q.Path, func(t *testing.T) {
+			require.NotNil(t, gapp.GRPCQueryRouter().Route(q.Path))
+
+			var data []byte
+			if req, ok := requests[q.Path]; ok {
+				data, err = proto.Marshal(req)
+				require.NoError(t, err)
+			}
+
+			query := func() ([]byte, string, storetypes.Gas) {
+				queryCtx, _ := ctx.CacheContext()
+				queryCtx = queryCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+				bz, err := stargate(queryCtx, &wasmvmtypes.StargateQuery{Path: q.Path, Data: data})
+				if err != nil {
+					return nil, err.Error(), queryCtx.GasMeter().GasConsumed()
+				}
+				return bz, "", queryCtx.GasMeter().GasConsumed()
+			}
+
+			bz, errMsg, gas := query()
+			if _, ok := requests[q.Path]; ok {
+				require.Empty(t, errMsg)
+				require.NotEmpty(t, bz)
+			}
+
+			for i := 0; i < 3; i++ {
+				again, againErrMsg, againGas := query()
+				require.Equal(t, bz, again)
+				require.Equal(t, errMsg, againErrMsg)
+				require.Equal(t, gas, againGas)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package decorators
+
+import (
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	globalfeetypes "github.com/outbe/outbe-node/x/globalfee/types"
+)
+
+// GlobalFeeKeeper is the on-chain source of the minimum fee (x/globalfee).
+type GlobalFeeKeeper interface {
+	GetParams(ctx context.Context) globalfeetypes.Params
+}
+
+// GlobalFeeChecker returns an ante.TxFeeChecker enforcing the governance
+// controlled minimum gas prices in addition to the validator's local ones.
+//
+// The global prices are consensus state and apply to every tx after genesis,
+// while the local prices only apply in CheckTx. When both define a price for
+// the same denom the higher one wins. Once a global list is set, fees may
+// only be paid in its denoms. A tx made only of bypass messages is free as
+// long as its gas limit stays under the bypass cap.
+func GlobalFeeChecker(keeper GlobalFeeKeeper) ante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		// gentxs are delivered without fees
+		if ctx.BlockHeight() == 0 {
+			return feeCoins, txPriority(feeCoins, int64(gas)), nil
+		}
+
+		params := keeper.GetParams(ctx)
+		if isBypassTx(params, tx.GetMsgs(), gas) {
+			return feeCoins, txPriority(feeCoins, int64(gas)), nil
+		}
+
+		var localPrices sdk.DecCoins
+		if ctx.IsCheckTx() {
+			localPrices = ctx.MinGasPrices()
+		}
+
+		prices := combinedGasPrices(params.MinimumGasPrices, localPrices)
+		if !params.MinimumGasPrices.IsZero() {
+			for _, c := range feeCoins {
+				if params.MinimumGasPrices.AmountOf(c.Denom).IsZero() {
+					return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s is not accepted; accepted gas prices: %s", c.Denom, params.MinimumGasPrices)
+				}
+			}
+		}
+
+		if !prices.IsZero() {
+			requiredFees := make(sdk.Coins, len(prices))
+
+			// fee = ceil(minGasPrice * gasLimit), as in the SDK default checker
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range prices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+
+		return feeCoins, txPriority(feeCoins, int64(gas)), nil
+	}
+}
+
+// isBypassTx reports whether all msgs are bypass message types and the gas
+// limit is within the bypass cap.
+func isBypassTx(params globalfeetypes.Params, msgs []sdk.Msg, gas uint64) bool {
+	if len(msgs) == 0 || gas > params.MaxTotalBypassMinFeeMsgGasUsage {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !params.IsBypassMsgType(sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// combinedGasPrices merges the global and local minimum gas prices. Without a
+// global list the local prices apply as is. Otherwise only the global denoms
+// are accepted, each at the higher of its global and local price.
+func combinedGasPrices(global, local sdk.DecCoins) sdk.DecCoins {
+	if global.IsZero() {
+		return local
+	}
+
+	combined := make(sdk.DecCoins, len(global))
+	for i, gp := range global {
+		if lp := local.AmountOf(gp.Denom); lp.GT(gp.Amount) {
+			gp = sdk.NewDecCoinFromDec(gp.Denom, lp)
+		}
+		combined[i] = gp
+	}
+
+	return combined
+}
+
+// txPriority mirrors the SDK default: the smallest gas price among the fee
+// coins.
+func txPriority(fee sdk.Coins, gas int64) int64 {
+	if gas <= 0 {
+		return 0
+	}
+
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
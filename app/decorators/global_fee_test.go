@@ -0,0 +1,90 @@
+package decorators_test
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/outbe/outbe-node/app/decorators"
+	globalfeetypes "github.com/outbe/outbe-node/x/globalfee/types"
+)
+
+type mockGlobalFeeKeeper struct {
+	params globalfeetypes.Params
+}
+
+func (k mockGlobalFeeKeeper) GetParams(_ context.Context) globalfeetypes.Params {
+	return k.params
+}
+
+// Test the global fee checker with global, local and bypass requirements
+func (s *AnteTestSuite) TestGlobalFeeChecker() {
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(acc, acc, sdk.NewCoins(sdk.NewCoin("unit", sdkmath.NewInt(1))))
+
+	coins := func(s string) sdk.Coins {
+		c, err := sdk.ParseCoinsNormalized(s)
+		if err != nil {
+			panic(err)
+		}
+		return c
+	}
+	prices := func(s string) sdk.DecCoins {
+		c, err := sdk.ParseDecCoins(s)
+		if err != nil {
+			panic(err)
+		}
+		return c
+	}
+
+	params := globalfeetypes.NewParams(prices("0.01unit,0.001uatom"), globalfeetypes.DefaultBypassMinFeeMsgTypes(), 200_000)
+	checker := decorators.GlobalFeeChecker(mockGlobalFeeKeeper{params: params})
+	ctx := s.ctx.WithBlockHeight(10)
+
+	for _, tc := range []struct {
+		name     string
+		ctx      sdk.Context
+		tx       sdk.Tx
+		err      error
+		priority int64
+	}{
+		{"enough in first denom", ctx, decorators.NewMockFeeTx(coins("1000unit"), 100_000, send), nil, 0},
+		{"enough in second denom", ctx, decorators.NewMockFeeTx(coins("100uatom"), 100_000, send), nil, 0},
+		{"insufficient", ctx, decorators.NewMockFeeTx(coins("999unit,99uatom"), 100_000, send), sdkerrors.ErrInsufficientFee, 0},
+		{"no fee", ctx, decorators.NewMockFeeTx(nil, 100_000, send), sdkerrors.ErrInsufficientFee, 0},
+		{"unaccepted denom", ctx, decorators.NewMockFeeTx(coins("1000unit,1stake"), 100_000, send), sdkerrors.ErrInvalidCoins, 0},
+		{"local price above global", ctx.WithIsCheckTx(true).WithMinGasPrices(prices("0.02unit")), decorators.NewMockFeeTx(coins("1000unit"), 100_000, send), sdkerrors.ErrInsufficientFee, 0},
+		{"local price ignored in deliver", ctx.WithMinGasPrices(prices("0.02unit")), decorators.NewMockFeeTx(coins("1000unit"), 100_000, send), nil, 0},
+		{"genesis", s.ctx.WithBlockHeight(0), decorators.NewMockFeeTx(nil, 100_000, send), nil, 0},
+		{"bypass", ctx, decorators.NewMockFeeTx(nil, 200_000, &clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{}), nil, 0},
+		{"bypass over gas cap", ctx, decorators.NewMockFeeTx(nil, 200_001, &clienttypes.MsgUpdateClient{}), sdkerrors.ErrInsufficientFee, 0},
+		{"bypass mixed with other msgs", ctx, decorators.NewMockFeeTx(nil, 100_000, &clienttypes.MsgUpdateClient{}, send), sdkerrors.ErrInsufficientFee, 0},
+		{"priority", ctx, decorators.NewMockFeeTx(coins("300000unit"), 100_000, send), nil, 3},
+	} {
+		s.Run(tc.name, func() {
+			_, priority, err := checker(tc.ctx, tc.tx)
+			if tc.err != nil {
+				s.Require().ErrorIs(err, tc.err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.priority, priority)
+		})
+	}
+
+	// without a global list only the local prices apply
+	checker = decorators.GlobalFeeChecker(mockGlobalFeeKeeper{params: globalfeetypes.DefaultParams()})
+	_, _, err := checker(ctx, decorators.NewMockFeeTx(nil, 100_000, send))
+	s.Require().NoError(err)
+	_, _, err = checker(ctx.WithIsCheckTx(true).WithMinGasPrices(prices("0.01stake")), decorators.NewMockFeeTx(coins("1000stake"), 100_000, send))
+	s.Require().NoError(err)
+	_, _, err = checker(ctx.WithIsCheckTx(true).WithMinGasPrices(prices("0.01stake")), decorators.NewMockFeeTx(coins("999stake"), 100_000, send))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
@@ -0,0 +1,123 @@
+package decorators
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+// DefaultMaxNestedMsgDepth is the default number of container messages that may
+// be nested inside each other before a tx is rejected.
+const DefaultMaxNestedMsgDepth = 6
+
+// MsgWalker visits every message of a tx, including the ones wrapped by the
+// container messages registered in ChainApp:
+//   - authz MsgExec
+//   - group MsgSubmitProposal
+//   - gov v1 MsgSubmitProposal
+//   - ICA controller MsgSendTx (the CosmosTx in the packet data)
+//
+// A walker without a codec does not look into ICA packet data, and packet data
+// it can not decode is treated as opaque.
+type MsgWalker struct {
+	cdc      codec.Codec
+	maxDepth int
+}
+
+// NewMsgWalker returns a MsgWalker. A maxDepth of zero uses DefaultMaxNestedMsgDepth.
+func NewMsgWalker(cdc codec.Codec, maxDepth int) MsgWalker {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxNestedMsgDepth
+	}
+
+	return MsgWalker{
+		cdc:      cdc,
+		maxDepth: maxDepth,
+	}
+}
+
+// MaxDepth returns the maximum nesting depth of the walker.
+func (w MsgWalker) MaxDepth() int {
+	return w.maxDepth
+}
+
+// Walk calls fn for each message in msgs and, depth first, for each message
+// nested in it. Top level messages have depth 0. Walking stops at the first
+// error returned by fn. Messages nested deeper than the max depth or container
+// messages that cannot be unpacked are reported as errors.
+func (w MsgWalker) Walk(msgs []sdk.Msg, fn func(msg sdk.Msg, depth int) error) error {
+	return w.walk(msgs, 0, fn)
+}
+
+func (w MsgWalker) walk(msgs []sdk.Msg, depth int, fn func(msg sdk.Msg, depth int) error) error {
+	if depth > w.maxDepth {
+		return fmt.Errorf("messages nested deeper than %d levels", w.maxDepth)
+	}
+
+	for _, msg := range msgs {
+		if err := fn(msg, depth); err != nil {
+			return err
+		}
+
+		inner, err := w.InnerMsgs(msg)
+		if err != nil {
+			return err
+		}
+
+		if len(inner) == 0 {
+			continue
+		}
+
+		if err := w.walk(inner, depth+1, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InnerMsgs returns the messages directly wrapped by msg, or nil if msg is not a
+// known container.
+func (w MsgWalker) InnerMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch m := msg.(type) {
+	case *authz.MsgExec:
+		return m.GetMessages()
+	case *group.MsgSubmitProposal:
+		return m.GetMsgs()
+	case *govv1.MsgSubmitProposal:
+		return m.GetMsgs()
+	case *icacontrollertypes.MsgSendTx:
+		if w.cdc == nil {
+			return nil, nil
+		}
+
+		return w.icaMsgs(m.PacketData.Data)
+	}
+
+	return nil, nil
+}
+
+// icaMsgs decodes the CosmosTx of an interchain account packet. The encoding of
+// the host is not known here, so both supported encodings are tried. Packet
+// data that decodes with neither, e.g. messages of types only the host
+// registers, is opaque and has no inner messages: what the interchain account
+// executes is checked on the host, by its FilteredMsgRouter.
+func (w MsgWalker) icaMsgs(data []byte) ([]sdk.Msg, error) {
+	msgs, err := icatypes.DeserializeCosmosTx(w.cdc, data, icatypes.EncodingProtobuf)
+	if err == nil {
+		return msgs, nil
+	}
+
+	msgs, err = icatypes.DeserializeCosmosTx(w.cdc, data, icatypes.EncodingProto3JSON)
+	if err == nil {
+		return msgs, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package decorators_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/outbe/outbe-node/app/decorators"
+)
+
+func TestMsgWalkerContainers(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(acc, acc, sdk.NewCoins(sdk.NewCoin("unit", sdkmath.NewInt(1))))
+
+	icaData, err := icatypes.SerializeCosmosTx(cdc, []proto.Message{send}, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	govProposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{send}, nil, acc.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+
+	groupProposal := &group.MsgSubmitProposal{GroupPolicyAddress: acc.String(), Proposers: []string{acc.String()}}
+	require.NoError(t, groupProposal.SetMsgs([]sdk.Msg{send}))
+
+	execMsg := authz.NewMsgExec(acc, []sdk.Msg{send})
+
+	for _, tc := range []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{"authz exec", &execMsg},
+		{"gov proposal", govProposal},
+		{"group proposal", groupProposal},
+		{"ica send tx", icacontrollertypes.NewMsgSendTx(acc.String(), "connection-0", 1, icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: icaData,
+		})},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var visited []string
+			err := decorators.NewMsgWalker(cdc, 0).Walk([]sdk.Msg{tc.msg}, func(msg sdk.Msg, depth int) error {
+				if depth == 1 {
+					visited = append(visited, sdk.MsgTypeURL(msg))
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, []string{sdk.MsgTypeURL(send)}, visited)
+		})
+	}
+}
+
+func TestMsgWalkerOpaqueICAData(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// a message type of the host only, unknown to the controller
+	sendTx := icacontrollertypes.NewMsgSendTx(acc.String(), "connection-0", 1, icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte(`{"messages":[{"@type":"/host.v1.MsgUnknown"}]}`),
+	})
+
+	inner, err := decorators.NewMsgWalker(cdc, 0).InnerMsgs(sendTx)
+	require.NoError(t, err)
+	require.Empty(t, inner)
+}
+
+func TestMsgWalkerMaxDepth(t *testing.T) {
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	var msg sdk.Msg = banktypes.NewMsgSend(acc, acc, sdk.NewCoins(sdk.NewCoin("unit", sdkmath.NewInt(1))))
+	for i := 0; i < 3; i++ {
+		execMsg := authz.NewMsgExec(acc, []sdk.Msg{msg})
+		msg = &execMsg
+	}
+
+	noop := func(sdk.Msg, int) error { return nil }
+	require.NoError(t, decorators.NewMsgWalker(nil, 3).Walk([]sdk.Msg{msg}, noop))
+	require.Error(t, decorators.NewMsgWalker(nil, 2).Walk([]sdk.Msg{msg}, noop))
+}
@@ -1,16 +1,41 @@
 package decorators
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/gogoproto/proto"
 )
 
+// MsgFilterKeeper is the on-chain source of blocked message types (x/msgfilter).
+type MsgFilterKeeper interface {
+	IsBlocked(ctx context.Context, typeURL string, signers []string) bool
+}
+
+// MsgFilterKeepers combines several sources of blocked message types. A message
+// is blocked if any of them blocks it.
+type MsgFilterKeepers []MsgFilterKeeper
+
+var _ MsgFilterKeeper = MsgFilterKeepers{}
+
+// IsBlocked implements MsgFilterKeeper.
+func (ks MsgFilterKeepers) IsBlocked(ctx context.Context, typeURL string, signers []string) bool {
+	for _, k := range ks {
+		if k.IsBlocked(ctx, typeURL, signers) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MsgFilterDecorator is an ante.go decorator template for filtering messages.
 type MsgFilterDecorator struct {
 	blockedTypes []sdk.Msg
+	keeper       MsgFilterKeeper
+	walker       MsgWalker
 }
 
 // FilterDecorator returns a new MsgFilterDecorator. This errors if the transaction
@@ -22,38 +47,73 @@ type MsgFilterDecorator struct {
 func FilterDecorator(blockedMsgTypes ...sdk.Msg) MsgFilterDecorator {
 	return MsgFilterDecorator{
 		blockedTypes: blockedMsgTypes,
+		walker:       NewMsgWalker(nil, DefaultMaxNestedMsgDepth),
+	}
+}
+
+// GovFilterDecorator returns a new MsgFilterDecorator that reads the blocked
+// message types from the governance controlled x/msgfilter params on every tx.
+// Nested messages are inspected with the given walker.
+func GovFilterDecorator(keeper MsgFilterKeeper, walker MsgWalker) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		keeper: keeper,
+		walker: walker,
 	}
 }
 
 func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if mfd.HasDisallowedMessage(ctx, tx.GetMsgs()) {
-		currHeight := ctx.BlockHeight()
-		return ctx, fmt.Errorf("tx contains unsupported message types at height %d", currHeight)
+	if err := mfd.CheckMsgs(ctx, tx.GetMsgs(), txSigners(tx)); err != nil {
+		return ctx, err
 	}
 
 	return next(ctx, tx, simulate)
 }
 
-func (mfd MsgFilterDecorator) HasDisallowedMessage(ctx sdk.Context, msgs []sdk.Msg) bool {
-	for _, msg := range msgs {
-		// check nested messages in a recursive manner
-		if execMsg, ok := msg.(*authz.MsgExec); ok {
-			msgs, err := execMsg.GetMessages()
-			if err != nil {
-				return true
-			}
+// HasDisallowedMessage reports whether any of msgs, or any message nested in
+// them, is blocked for the given signers.
+func (mfd MsgFilterDecorator) HasDisallowedMessage(ctx sdk.Context, msgs []sdk.Msg, signers []string) bool {
+	return mfd.CheckMsgs(ctx, msgs, signers) != nil
+}
 
-			if mfd.HasDisallowedMessage(ctx, msgs) {
-				return true
-			}
+// CheckMsgs returns an error if any of msgs, or any message nested in them, is
+// blocked for the given signers or if the messages are nested too deep.
+func (mfd MsgFilterDecorator) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg, signers []string) error {
+	return mfd.walker.Walk(msgs, func(msg sdk.Msg, _ int) error {
+		if mfd.isBlocked(ctx, msg, signers) {
+			return fmt.Errorf("tx contains unsupported message types at height %d", ctx.BlockHeight())
 		}
 
-		for _, blockedType := range mfd.blockedTypes {
-			if proto.MessageName(msg) == proto.MessageName(blockedType) {
-				return true
-			}
+		return nil
+	})
+}
+
+func (mfd MsgFilterDecorator) isBlocked(ctx sdk.Context, msg sdk.Msg, signers []string) bool {
+	for _, blockedType := range mfd.blockedTypes {
+		if proto.MessageName(msg) == proto.MessageName(blockedType) {
+			return true
 		}
 	}
 
-	return false
+	return mfd.keeper != nil && mfd.keeper.IsBlocked(ctx, sdk.MsgTypeURL(msg), signers)
+}
+
+// txSigners returns the bech32 signer addresses of tx, or nil if tx does not
+// expose them.
+func txSigners(tx sdk.Tx) []string {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil
+	}
+
+	addrs := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		addrs = append(addrs, sdk.AccAddress(signer).String())
+	}
+
+	return addrs
 }
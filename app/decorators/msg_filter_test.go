@@ -1,12 +1,14 @@
 package decorators_test
 
 import (
+	"context"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 
@@ -45,3 +47,62 @@ func (s *AnteTestSuite) TestAnteMsgFilterLogic() {
 	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(msgMultiSend), false, decorators.EmptyAnte)
 	s.Require().NoError(err)
 }
+
+type mockFilterKeeper struct {
+	blocked map[string]bool
+}
+
+func (k mockFilterKeeper) IsBlocked(_ context.Context, typeURL string, _ []string) bool {
+	return k.blocked[typeURL]
+}
+
+// Test the governance controlled filter, including messages nested in authz.MsgExec
+func (s *AnteTestSuite) TestAnteGovMsgFilterLogic() {
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ante := decorators.GovFilterDecorator(mockFilterKeeper{
+		blocked: map[string]bool{sdk.MsgTypeURL(&banktypes.MsgSend{}): true},
+	}, decorators.NewMsgWalker(nil, 0))
+	msg := banktypes.NewMsgSend(
+		acc,
+		acc,
+		sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))),
+	)
+	_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(msg), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	execMsg := authz.NewMsgExec(acc, []sdk.Msg{msg})
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(&execMsg), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	msgMultiSend := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1)))),
+		[]banktypes.Output{banktypes.NewOutput(acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))))},
+	)
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(msgMultiSend), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+}
+
+// Test that combined filter keepers block a message if any of them does
+func (s *AnteTestSuite) TestAnteMsgFilterKeepers() {
+	acc := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	ante := decorators.GovFilterDecorator(decorators.MsgFilterKeepers{
+		mockFilterKeeper{blocked: map[string]bool{sdk.MsgTypeURL(&banktypes.MsgSend{}): true}},
+		mockFilterKeeper{blocked: map[string]bool{sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true}},
+	}, decorators.NewMsgWalker(nil, 0))
+
+	msg := banktypes.NewMsgSend(acc, acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))))
+	_, err := ante.AnteHandle(s.ctx, decorators.NewMockTx(msg), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	msgMultiSend := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1)))),
+		[]banktypes.Output{banktypes.NewOutput(acc, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1))))},
+	)
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(msgMultiSend), false, decorators.EmptyAnte)
+	s.Require().Error(err)
+
+	_, err = ante.AnteHandle(s.ctx, decorators.NewMockTx(&banktypes.MsgUpdateParams{}), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+}
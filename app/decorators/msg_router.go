@@ -0,0 +1,65 @@
+package decorators
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRouter routes messages to their handlers. It is implemented by the
+// baseapp.MsgServiceRouter and used by CosmWasm and the ICA host to execute
+// messages outside of the ante chain.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// FilteredMsgRouter applies a MsgFilterDecorator to every message it routes, so
+// that messages dispatched by wasm contracts or executed for interchain
+// accounts are subject to the same filter as regular txs.
+type FilteredMsgRouter struct {
+	router MsgRouter
+	filter MsgFilterDecorator
+	cdc    codec.Codec
+}
+
+var _ MsgRouter = FilteredMsgRouter{}
+
+// NewFilteredMsgRouter wraps router with filter. The codec is used to resolve
+// the signers of routed messages for the filter exemptions.
+func NewFilteredMsgRouter(router MsgRouter, filter MsgFilterDecorator, cdc codec.Codec) FilteredMsgRouter {
+	return FilteredMsgRouter{
+		router: router,
+		filter: filter,
+		cdc:    cdc,
+	}
+}
+
+// Handler returns the handler of the wrapped router, guarded by the filter.
+func (r FilteredMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.filter.CheckMsgs(ctx, []sdk.Msg{msg}, r.msgSigners(msg)); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, msg)
+	}
+}
+
+func (r FilteredMsgRouter) msgSigners(msg sdk.Msg) []string {
+	signers, _, err := r.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil
+	}
+
+	addrs := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		addrs = append(addrs, sdk.AccAddress(signer).String())
+	}
+
+	return addrs
+}
@@ -33,3 +33,45 @@ func (tx MockTx) GetMsgsV2() ([]protov2.Message, error) {
 func (tx MockTx) ValidateBasic() error {
 	return nil
 }
+
+// MockFeeTx is a MockTx carrying a fee and gas limit.
+type MockFeeTx struct {
+	MockTx
+
+	fee     sdk.Coins
+	gas     uint64
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+var _ sdk.FeeTx = MockFeeTx{}
+
+func NewMockFeeTx(fee sdk.Coins, gas uint64, msgs ...sdk.Msg) MockFeeTx {
+	return MockFeeTx{
+		MockTx: NewMockTx(msgs...),
+		fee:    fee,
+		gas:    gas,
+	}
+}
+
+// WithFeePayer returns a copy of the tx paid by payer and, if set, granter.
+func (tx MockFeeTx) WithFeePayer(payer, granter sdk.AccAddress) MockFeeTx {
+	tx.payer, tx.granter = payer, granter
+	return tx
+}
+
+func (tx MockFeeTx) GetGas() uint64 {
+	return tx.gas
+}
+
+func (tx MockFeeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx MockFeeTx) FeePayer() []byte {
+	return tx.payer
+}
+
+func (tx MockFeeTx) FeeGranter() []byte {
+	return tx.granter
+}
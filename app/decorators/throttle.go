@@ -0,0 +1,105 @@
+package decorators
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// ThrottleKeeper limits the txs and gas of each signer over a window of
+// blocks (x/throttle).
+type ThrottleKeeper interface {
+	Throttle(ctx sdk.Context, signers []sdk.AccAddress, typeURLs []string, gasWanted uint64) error
+}
+
+// MaxTxGasWantedDecorator rejects txs entering the mempool that want more gas
+// than the node accepts. Zero disables it.
+//
+// The limit is set in app.toml, so validators may set different ones. It is
+// mempool only on purpose: enforced when txs are executed, a block would fail
+// a tx on some validators and not on others, and they would disagree on the
+// app hash. The limit every node enforces on executed txs is the max gas of
+// the block in the consensus params.
+type MaxTxGasWantedDecorator struct {
+	maxGasWanted uint64
+}
+
+// NewMaxTxGasWantedDecorator returns a new MaxTxGasWantedDecorator.
+func NewMaxTxGasWantedDecorator(maxGasWanted uint64) MaxTxGasWantedDecorator {
+	return MaxTxGasWantedDecorator{maxGasWanted: maxGasWanted}
+}
+
+func (d MaxTxGasWantedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.maxGasWanted == 0 || !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	if gas := feeTx.GetGas(); gas > d.maxGasWanted {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidGasLimit, "tx wants %d gas, above the limit of %d", gas, d.maxGasWanted)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ThrottleDecorator hands the signers, message types and gas wanted of every
+// tx to x/throttle. The message types include the ones nested in container
+// messages, so that wrapping a message does not make it exempt. It must run
+// after the signatures are verified, so that nobody can use up the quota of
+// another account.
+type ThrottleDecorator struct {
+	keeper ThrottleKeeper
+	walker MsgWalker
+}
+
+// NewThrottleDecorator returns a new ThrottleDecorator.
+func NewThrottleDecorator(keeper ThrottleKeeper, walker MsgWalker) ThrottleDecorator {
+	return ThrottleDecorator{keeper: keeper, walker: walker}
+}
+
+func (d ThrottleDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// rechecked txs were counted when they entered the mempool. Simulated txs
+	// run on a branch of the state, so that they are charged the gas of the
+	// check without being recorded.
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a SigVerifiableTx")
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+
+	signerBytes, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	signers := make([]sdk.AccAddress, 0, len(signerBytes))
+	for _, s := range signerBytes {
+		signers = append(signers, s)
+	}
+
+	var typeURLs []string
+	if err := d.walker.Walk(tx.GetMsgs(), func(msg sdk.Msg, _ int) error {
+		typeURLs = append(typeURLs, sdk.MsgTypeURL(msg))
+		return nil
+	}); err != nil {
+		return ctx, err
+	}
+
+	if err := d.keeper.Throttle(ctx, signers, typeURLs, feeTx.GetGas()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,111 @@
+package decorators_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/outbe/outbe-node/app/decorators"
+)
+
+type throttledTx struct {
+	signers   []sdk.AccAddress
+	typeURLs  []string
+	gasWanted uint64
+}
+
+// throttleGas is the gas the mock keeper charges per tx.
+const throttleGas = 1_000
+
+type mockThrottleKeeper struct {
+	throttled *[]throttledTx
+}
+
+func (k mockThrottleKeeper) Throttle(ctx sdk.Context, signers []sdk.AccAddress, typeURLs []string, gasWanted uint64) error {
+	ctx.GasMeter().ConsumeGas(throttleGas, "throttle")
+	*k.throttled = append(*k.throttled, throttledTx{signers, typeURLs, gasWanted})
+	return nil
+}
+
+// Test the max gas wanted decorator only limits txs entering the mempool
+func (s *AnteTestSuite) TestAnteMaxTxGasWanted() {
+	tx := decorators.NewMockFeeTx(sdk.NewCoins(), 200_000)
+
+	for _, tc := range []struct {
+		name     string
+		max      uint64
+		ctx      sdk.Context
+		simulate bool
+		err      error
+	}{
+		{"below limit", 200_000, s.ctx.WithIsCheckTx(true), false, nil},
+		{"above limit", 199_999, s.ctx.WithIsCheckTx(true), false, sdkerrors.ErrInvalidGasLimit},
+		{"no limit", 0, s.ctx.WithIsCheckTx(true), false, nil},
+		{"deliver tx", 199_999, s.ctx, false, nil},
+		{"simulate", 199_999, s.ctx.WithIsCheckTx(true), true, nil},
+	} {
+		s.Run(tc.name, func() {
+			_, err := decorators.NewMaxTxGasWantedDecorator(tc.max).AnteHandle(tc.ctx, tx, tc.simulate, decorators.EmptyAnte)
+			s.Require().ErrorIs(err, tc.err)
+		})
+	}
+}
+
+// Test the throttle decorator passes the signers, message types and gas of a tx
+func (s *AnteTestSuite) TestAnteThrottle() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, authzmodule.AppModuleBasic{})
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin("unit", sdkmath.NewInt(1)))
+
+	builder := encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(
+		banktypes.NewMsgSend(from, to, coins),
+		banktypes.NewMsgSend(to, from, coins),
+	))
+	builder.SetGasLimit(300_000)
+	tx := builder.GetTx()
+
+	var throttled []throttledTx
+	decorator := decorators.NewThrottleDecorator(mockThrottleKeeper{throttled: &throttled}, decorators.NewMsgWalker(nil, 0))
+	ctx := s.ctx.WithGasMeter(storetypes.NewGasMeter(500_000))
+
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(true).WithIsReCheckTx(true), tx, false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().Empty(throttled)
+
+	_, err = decorator.AnteHandle(ctx, tx, false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().Equal([]throttledTx{{
+		signers:   []sdk.AccAddress{from, to},
+		typeURLs:  []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+		gasWanted: 300_000,
+	}}, throttled)
+	// the gas charged by the keeper is charged to the tx
+	s.Require().Equal(uint64(throttleGas), ctx.GasMeter().GasConsumed())
+
+	// simulated txs are checked too, so that they are charged the same gas
+	_, err = decorator.AnteHandle(ctx, tx, true, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().Len(throttled, 2)
+	s.Require().Equal(uint64(2*throttleGas), ctx.GasMeter().GasConsumed())
+
+	// nested messages are throttled along with their container
+	execMsg := authz.NewMsgExec(from, []sdk.Msg{banktypes.NewMsgSend(to, from, coins)})
+	s.Require().NoError(builder.SetMsgs(&execMsg))
+	throttled = nil
+	_, err = decorator.AnteHandle(ctx, builder.GetTx(), false, decorators.EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"/cosmos.authz.v1beta1.MsgExec", "/cosmos.bank.v1beta1.MsgSend"}, throttled[0].typeURLs)
+
+	_, err = decorator.AnteHandle(ctx, decorators.NewMockTx(), false, decorators.EmptyAnte)
+	s.Require().ErrorIs(err, sdkerrors.ErrTxDecode)
+}
@@ -0,0 +1,44 @@
+package decorators
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxFeesKeeper refunds fees for unused gas and records fees paid (x/txfees).
+type TxFeesKeeper interface {
+	ProcessTx(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, gasWanted, gasUsed uint64) error
+}
+
+// TxFeesPostDecorator hands every successfully executed tx to x/txfees.
+type TxFeesPostDecorator struct {
+	keeper TxFeesKeeper
+}
+
+// NewTxFeesPostDecorator returns a new TxFeesPostDecorator.
+func NewTxFeesPostDecorator(keeper TxFeesKeeper) TxFeesPostDecorator {
+	return TxFeesPostDecorator{keeper: keeper}
+}
+
+func (d TxFeesPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	// messages are not executed in CheckTx, there is nothing to refund yet
+	if !ok || !success || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	if granter := feeTx.FeeGranter(); len(granter) != 0 {
+		payer = granter
+	}
+
+	// the refund and accounting are not charged to the tx, so that they can
+	// neither change its gas used nor run it out of gas
+	gasUsed := ctx.GasMeter().GasConsumed()
+	if err := d.keeper.ProcessTx(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), payer, feeTx.GetFee(), feeTx.GetGas(), gasUsed); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
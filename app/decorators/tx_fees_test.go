@@ -0,0 +1,70 @@
+package decorators_test
+
+import (
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/app/decorators"
+)
+
+type processedTx struct {
+	payer     sdk.AccAddress
+	fee       sdk.Coins
+	gasWanted uint64
+	gasUsed   uint64
+}
+
+type mockTxFeesKeeper struct {
+	processed *[]processedTx
+}
+
+func (k mockTxFeesKeeper) ProcessTx(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, gasWanted, gasUsed uint64) error {
+	// charged gas must not end up on the tx
+	ctx.GasMeter().ConsumeGas(1_000_000, "process tx")
+	*k.processed = append(*k.processed, processedTx{payer, fee, gasWanted, gasUsed})
+	return nil
+}
+
+// Test the tx fees post decorator only processes executed txs with the right payer
+func (s *AnteTestSuite) TestTxFeesPostDecorator() {
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("unit", 1000))
+
+	var processed []processedTx
+	decorator := decorators.NewTxFeesPostDecorator(mockTxFeesKeeper{processed: &processed})
+	next := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	newCtx := func() sdk.Context {
+		meter := storetypes.NewGasMeter(200_000)
+		meter.ConsumeGas(50_000, "tx")
+		return s.ctx.WithGasMeter(meter).WithEventManager(sdk.NewEventManager())
+	}
+	tx := decorators.NewMockFeeTx(fee, 200_000).WithFeePayer(payer, nil)
+
+	for _, tc := range []struct {
+		name    string
+		ctx     sdk.Context
+		tx      sdk.Tx
+		success bool
+		want    []processedTx
+	}{
+		{"not a fee tx", newCtx(), decorators.NewMockTx(), true, nil},
+		{"failed tx", newCtx(), tx, false, nil},
+		{"check tx", newCtx().WithIsCheckTx(true), tx, true, nil},
+		{"recheck tx", newCtx().WithIsReCheckTx(true), tx, true, nil},
+		{"payer", newCtx(), tx, true, []processedTx{{payer, fee, 200_000, 50_000}}},
+		{"granter", newCtx(), tx.WithFeePayer(payer, granter), true, []processedTx{{granter, fee, 200_000, 50_000}}},
+	} {
+		s.Run(tc.name, func() {
+			processed = nil
+			ctx, err := decorator.PostHandle(tc.ctx, tc.tx, false, tc.success, next)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.want, processed)
+			s.Require().Equal(uint64(50_000), ctx.GasMeter().GasConsumed())
+		})
+	}
+}
@@ -2,8 +2,8 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -11,6 +11,7 @@ import (
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -19,27 +20,70 @@ import (
 // ExportAppStateAndValidators exports the state of the application for a genesis
 // file.
 func (app *ChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, error) {
+	exported, _, err := app.ExportAppStateAndValidatorsWithReport(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	return exported, err
+}
+
+// ExportAppStateAndValidatorsWithReport is ExportAppStateAndValidators also
+// returning the state changes made to prepare a zero height export.
+func (app *ChainApp) ExportAppStateAndValidatorsWithReport(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string) (servertypes.ExportedApp, ZeroHeightReport, error) {
+	ctx, height, report, err := app.exportContext(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	exported, err := app.exportedApp(ctx, height, appState)
+	return exported, report, err
+}
+
+// DryRunZeroHeightExport prepares a zero height export in a cache that is
+// discarded and returns the state changes it made.
+func (app *ChainApp) DryRunZeroHeightExport(jailAllowedAddrs []string) (ZeroHeightReport, error) {
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	ctx, _ = ctx.CacheContext()
+
+	return app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+}
+
+// exportContext returns the context to export the state in and the height
+// the exported chain starts at, after preparing a zero height export.
+func (app *ChainApp) exportContext(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64, ZeroHeightReport, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
 
 	// We export at last height + 1, because that's the height at which
 	// CometBFT will start InitChain.
 	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	if !forZeroHeight {
+		return ctx, height, ZeroHeightReport{}, nil
 	}
 
-	genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	report, err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
 	if err != nil {
-		return servertypes.ExportedApp{}, err
+		return ctx, 0, report, fmt.Errorf("failed to prepare zero height genesis: %w", err)
 	}
 
-	appState, err := json.MarshalIndent(genState, "", "  ")
-	if err != nil {
-		return servertypes.ExportedApp{}, err
-	}
+	app.Logger().Info("prepared zero height genesis",
+		"commissions_withdrawn", len(report.CommissionsWithdrawn),
+		"rewards_withdrawn", len(report.RewardsWithdrawn),
+		"delegations_reset", len(report.DelegationsReset),
+		"validators_jailed", len(report.ValidatorsJailed),
+	)
+
+	return ctx, 0, report, nil
+}
 
+func (app *ChainApp) exportedApp(ctx sdk.Context, height int64, appState json.RawMessage) (servertypes.ExportedApp, error) {
 	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
 	if err != nil {
 		return servertypes.ExportedApp{}, err
@@ -53,68 +97,162 @@ func (app *ChainApp) ExportAppStateAndValidators(forZeroHeight bool, jailAllowed
 	}, nil
 }
 
+// ZeroHeightReport lists the state changes made to prepare a zero height
+// export.
+type ZeroHeightReport struct {
+	// Height is the last block height of the exported state.
+	Height               int64                  `json:"height"`
+	CommissionsWithdrawn []ZeroHeightWithdrawal `json:"commissions_withdrawn"`
+	RewardsWithdrawn     []ZeroHeightWithdrawal `json:"rewards_withdrawn"`
+	// RewardsDonated are the outstanding reward fractions left to validators
+	// after the withdrawals, moved to the community pool.
+	RewardsDonated            []ZeroHeightDonation     `json:"rewards_donated"`
+	DelegationsReset          []ZeroHeightDelegation   `json:"delegations_reset"`
+	RedelegationsReset        []ZeroHeightRedelegation `json:"redelegations_reset"`
+	UnbondingDelegationsReset []ZeroHeightDelegation   `json:"unbonding_delegations_reset"`
+	// ValidatorsReset are the validators whose unbonding height is reset.
+	ValidatorsReset []string `json:"validators_reset"`
+	// ValidatorsJailed are the validators jailed for not being in the jail
+	// allow-list.
+	ValidatorsJailed []string `json:"validators_jailed"`
+	// SigningInfosReset are the consensus addresses whose signing info start
+	// height is reset.
+	SigningInfosReset []string `json:"signing_infos_reset"`
+}
+
+// ZeroHeightWithdrawal is a commission, without delegator, or rewards
+// withdrawal.
+type ZeroHeightWithdrawal struct {
+	Validator string    `json:"validator"`
+	Delegator string    `json:"delegator,omitempty"`
+	Amount    sdk.Coins `json:"amount"`
+}
+
+type ZeroHeightDonation struct {
+	Validator string       `json:"validator"`
+	Amount    sdk.DecCoins `json:"amount"`
+}
+
+// ZeroHeightDelegation is a delegation re-initialized in distribution or,
+// with entries, an unbonding delegation whose creation heights are reset.
+type ZeroHeightDelegation struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+	Entries   int    `json:"entries,omitempty"`
+}
+
+type ZeroHeightRedelegation struct {
+	Delegator    string `json:"delegator"`
+	ValidatorSrc string `json:"validator_src"`
+	ValidatorDst string `json:"validator_dst"`
+	Entries      int    `json:"entries"`
+}
+
+// validateJailAllowedAddrs checks every jail allow-list entry is the operator
+// address of a validator, and returns them as a set.
+func (app *ChainApp) validateJailAllowedAddrs(ctx sdk.Context, jailAllowedAddrs []string) (map[string]bool, error) {
+	allowed := make(map[string]bool, len(jailAllowedAddrs))
+
+	var errs []error
+	for _, addr := range jailAllowedAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("jail allowed address %q: %w", addr, err))
+			continue
+		}
+
+		if allowed[valAddr.String()] {
+			errs = append(errs, fmt.Errorf("duplicate jail allowed address %s", addr))
+			continue
+		}
+		allowed[valAddr.String()] = true
+
+		if _, err := app.StakingKeeper.GetValidator(ctx, valAddr); err != nil {
+			errs = append(errs, fmt.Errorf("jail allowed address %s: %w", addr, err))
+		}
+	}
+
+	return allowed, errors.Join(errs...)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
 //	in favor of export at a block height
-func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
-	var err error
+func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) (ZeroHeightReport, error) {
+	report := ZeroHeightReport{Height: ctx.BlockHeight()}
+
+	allowedAddrsMap, err := app.validateJailAllowedAddrs(ctx, jailAllowedAddrs)
+	if err != nil {
+		return report, err
+	}
+	applyAllowedAddrs := len(allowedAddrsMap) > 0
 
 	// Just to be safe, assert the invariants on current state.
-	app.CrisisKeeper.AssertInvariants(ctx)
+	if err := assertInvariants(ctx, app); err != nil {
+		return report, err
+	}
 
 	// set context height to zero
 	height := ctx.BlockHeight()
 	ctx = ctx.WithBlockHeight(0)
 
-	applyAllowedAddrs := false
+	// Handle fee distribution state.
 
-	// check if there is a allowed address list
-	if len(jailAllowedAddrs) > 0 {
-		applyAllowedAddrs = true
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return report, err
 	}
 
-	allowedAddrsMap := make(map[string]bool)
-
-	for _, addr := range jailAllowedAddrs {
-		_, err := sdk.ValAddressFromBech32(addr)
+	// withdraw all validator commission
+	for _, val := range validators {
+		valBz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 		if err != nil {
-			log.Fatal(err)
+			return report, err
 		}
-		allowedAddrsMap[addr] = true
-	}
-
-	// Handle fee distribution state.
 
-	// withdraw all validator commission
-	err = app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		valBz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valBz)
+		if errors.Is(err, distrtypes.ErrNoValidatorCommission) {
+			continue
+		}
 		if err != nil {
-			panic(err)
+			return report, fmt.Errorf("withdraw commission of %s: %w", val.GetOperator(), err)
 		}
-		_, _ = app.DistrKeeper.WithdrawValidatorCommission(ctx, valBz)
-		return false
-	})
-	if err != nil {
-		panic(err)
+
+		report.CommissionsWithdrawn = append(report.CommissionsWithdrawn, ZeroHeightWithdrawal{
+			Validator: val.GetOperator(),
+			Amount:    commission,
+		})
 	}
 
 	// withdraw all delegator rewards
 	dels, err := app.StakingKeeper.GetAllDelegations(ctx)
 	if err != nil {
-		panic(err)
+		return report, err
 	}
 
 	for _, delegation := range dels {
 		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
 		if err != nil {
-			panic(err)
+			return report, err
 		}
 
-		delAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			return report, err
+		}
 
-		if _, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr); err != nil {
-			panic(err)
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return report, fmt.Errorf("withdraw rewards of %s from %s: %w", delegation.DelegatorAddress, delegation.ValidatorAddress, err)
+		}
+
+		if !rewards.IsZero() {
+			report.RewardsWithdrawn = append(report.RewardsWithdrawn, ZeroHeightWithdrawal{
+				Validator: delegation.ValidatorAddress,
+				Delegator: delegation.DelegatorAddress,
+				Amount:    rewards,
+			})
 		}
 	}
 
@@ -125,51 +263,61 @@ func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 	app.DistrKeeper.DeleteAllValidatorHistoricalRewards(ctx)
 
 	// reinitialize all validators
-	err = app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
+	for _, val := range validators {
 		valBz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 		if err != nil {
-			panic(err)
+			return report, err
 		}
+
 		// donate any unwithdrawn outstanding reward fraction tokens to the community pool
 		scraps, err := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valBz)
 		if err != nil {
-			panic(err)
+			return report, fmt.Errorf("outstanding rewards of %s: %w", val.GetOperator(), err)
 		}
 		feePool, err := app.DistrKeeper.FeePool.Get(ctx)
 		if err != nil {
-			panic(err)
+			return report, err
 		}
 		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
 		if err := app.DistrKeeper.FeePool.Set(ctx, feePool); err != nil {
-			panic(err)
+			return report, err
+		}
+
+		if !scraps.IsZero() {
+			report.RewardsDonated = append(report.RewardsDonated, ZeroHeightDonation{
+				Validator: val.GetOperator(),
+				Amount:    scraps,
+			})
 		}
 
 		if err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, valBz); err != nil {
-			panic(err)
+			return report, fmt.Errorf("reinitialize validator %s: %w", val.GetOperator(), err)
 		}
-		return false
-	})
-	if err != nil {
-		panic(err)
 	}
 
 	// reinitialize all delegations
 	for _, del := range dels {
 		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
 		if err != nil {
-			panic(err)
+			return report, err
+		}
+		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
+		if err != nil {
+			return report, err
 		}
-		delAddr := sdk.MustAccAddressFromBech32(del.DelegatorAddress)
 
 		if err := app.DistrKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
-			// never called as BeforeDelegationCreated always returns nil
-			panic(fmt.Errorf("error while incrementing period: %w", err))
+			return report, fmt.Errorf("error while incrementing period: %w", err)
 		}
 
 		if err := app.DistrKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
-			// never called as AfterDelegationModified always returns nil
-			panic(fmt.Errorf("error while creating a new delegation period record: %w", err))
+			return report, fmt.Errorf("error while creating a new delegation period record: %w", err)
 		}
+
+		report.DelegationsReset = append(report.DelegationsReset, ZeroHeightDelegation{
+			Delegator: del.DelegatorAddress,
+			Validator: del.ValidatorAddress,
+		})
 	}
 
 	// reset context height
@@ -178,18 +326,25 @@ func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 	// Handle staking state.
 
 	// iterate through redelegations, reset creation height
+	var iterErr error
 	err = app.StakingKeeper.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) (stop bool) {
 		for i := range red.Entries {
 			red.Entries[i].CreationHeight = 0
 		}
-		err = app.StakingKeeper.SetRedelegation(ctx, red)
-		if err != nil {
-			panic(err)
+		if iterErr = app.StakingKeeper.SetRedelegation(ctx, red); iterErr != nil {
+			return true
 		}
+
+		report.RedelegationsReset = append(report.RedelegationsReset, ZeroHeightRedelegation{
+			Delegator:    red.DelegatorAddress,
+			ValidatorSrc: red.ValidatorSrcAddress,
+			ValidatorDst: red.ValidatorDstAddress,
+			Entries:      len(red.Entries),
+		})
 		return false
 	})
-	if err != nil {
-		panic(err)
+	if err = errors.Join(err, iterErr); err != nil {
+		return report, fmt.Errorf("reset redelegations: %w", err)
 	}
 
 	// iterate through unbonding delegations, reset creation height
@@ -197,14 +352,19 @@ func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		for i := range ubd.Entries {
 			ubd.Entries[i].CreationHeight = 0
 		}
-		err = app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
-		if err != nil {
-			panic(err)
+		if iterErr = app.StakingKeeper.SetUnbondingDelegation(ctx, ubd); iterErr != nil {
+			return true
 		}
+
+		report.UnbondingDelegationsReset = append(report.UnbondingDelegationsReset, ZeroHeightDelegation{
+			Delegator: ubd.DelegatorAddress,
+			Validator: ubd.ValidatorAddress,
+			Entries:   len(ubd.Entries),
+		})
 		return false
 	})
-	if err != nil {
-		panic(err)
+	if err = errors.Join(err, iterErr); err != nil {
+		return report, fmt.Errorf("reset unbonding delegations: %w", err)
 	}
 
 	// Iterate through validators by power descending, reset bond heights, and
@@ -216,28 +376,29 @@ func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 		validator, err := app.StakingKeeper.GetValidator(ctx, addr)
 		if err != nil {
-			panic("expected validator, not found")
+			iter.Close()
+			return report, fmt.Errorf("validator %s: %w", addr, err)
 		}
 
 		validator.UnbondingHeight = 0
-		if applyAllowedAddrs && !allowedAddrsMap[addr.String()] {
+		report.ValidatorsReset = append(report.ValidatorsReset, addr.String())
+		if applyAllowedAddrs && !allowedAddrsMap[addr.String()] && !validator.Jailed {
 			validator.Jailed = true
+			report.ValidatorsJailed = append(report.ValidatorsJailed, addr.String())
 		}
 
-		err = app.StakingKeeper.SetValidator(ctx, validator)
-		if err != nil {
-			panic(err)
+		if err := app.StakingKeeper.SetValidator(ctx, validator); err != nil {
+			iter.Close()
+			return report, fmt.Errorf("validator %s: %w", addr, err)
 		}
 	}
 
 	if err := iter.Close(); err != nil {
-		app.Logger().Error("error while closing the key-value store reverse prefix iterator: ", err)
-		return
+		return report, fmt.Errorf("error while closing the key-value store reverse prefix iterator: %w", err)
 	}
 
-	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
-	if err != nil {
-		log.Fatal(err)
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return report, fmt.Errorf("apply validator set updates: %w", err)
 	}
 
 	// Handle slashing state.
@@ -247,13 +408,17 @@ func (app *ChainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		ctx,
 		func(addr sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) (stop bool) {
 			info.StartHeight = 0
-			if err := app.SlashingKeeper.SetValidatorSigningInfo(ctx, addr, info); err != nil {
-				panic(err)
+			if iterErr = app.SlashingKeeper.SetValidatorSigningInfo(ctx, addr, info); iterErr != nil {
+				return true
 			}
+
+			report.SigningInfosReset = append(report.SigningInfosReset, addr.String())
 			return false
 		},
 	)
-	if err != nil {
-		panic(err)
+	if err = errors.Join(err, iterErr); err != nil {
+		return report, fmt.Errorf("reset signing infos: %w", err)
 	}
+
+	return report, nil
 }
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestZeroHeightExport(t *testing.T) {
+	var validators []*cmttypes.Validator
+	for range 2 {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators = append(validators, cmttypes.NewValidator(pubKey, 1))
+	}
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+
+	app := SetupWithGenesisValSet(t, cmttypes.NewValidatorSet(validators), []authtypes.GenesisAccount{acc}, chainID, nil, balance)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	ctx := app.NewContext(true)
+	vals, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, vals, 2)
+	kept, jailed := vals[0].GetOperator(), vals[1].GetOperator()
+
+	report, err := app.DryRunZeroHeightExport(nil)
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), report.Height)
+	require.Len(t, report.DelegationsReset, 2)
+	require.Len(t, report.ValidatorsReset, 2)
+	require.Len(t, report.SigningInfosReset, 2)
+	require.Empty(t, report.ValidatorsJailed)
+
+	report, err = app.DryRunZeroHeightExport([]string{kept})
+	require.NoError(t, err)
+	require.Equal(t, []string{jailed}, report.ValidatorsJailed)
+
+	// the dry-run leaves the state untouched
+	jailedAddr, err := sdk.ValAddressFromBech32(jailed)
+	require.NoError(t, err)
+	val, err := app.StakingKeeper.GetValidator(app.NewContext(true), jailedAddr)
+	require.NoError(t, err)
+	require.False(t, val.Jailed)
+
+	// invalid allow-lists are rejected before any change
+	unknown := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	for name, addrs := range map[string][]string{
+		"invalid":   {kept, "outbevaloper1invalid"},
+		"duplicate": {kept, kept},
+		"unknown":   {unknown},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := app.DryRunZeroHeightExport(addrs)
+			require.ErrorContains(t, err, "jail allowed address")
+
+			_, _, err = app.ExportAppStateAndValidatorsWithReport(true, addrs, nil)
+			require.ErrorContains(t, err, "jail allowed address")
+		})
+	}
+
+	exported, report, err := app.ExportAppStateAndValidatorsWithReport(true, []string{kept}, nil)
+	require.NoError(t, err)
+	require.Zero(t, exported.Height)
+	require.Equal(t, []string{jailed}, report.ValidatorsJailed)
+	require.Len(t, report.DelegationsReset, 2)
+}
@@ -2,6 +2,13 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GenesisState of the blockchain is represented here as a map of raw json
@@ -12,3 +19,64 @@ import (
 // the ModuleBasicManager which populates json from each BasicModule
 // object provided to it during init.
 type GenesisState map[string]json.RawMessage
+
+// NativeDenomMetadata returns the bank metadata of BaseDenom, displayed as
+// DisplayDenom with BaseDenomUnit decimals.
+func NativeDenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "The native token of Outbe networks",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: BaseDenom, Exponent: 0},
+			{Denom: DisplayDenom, Exponent: uint32(BaseDenomUnit)},
+		},
+		Base:    BaseDenom,
+		Display: DisplayDenom,
+		Name:    DisplayDenom,
+		Symbol:  DisplayDenom,
+	}
+}
+
+// bankModuleBasic is the bank module with the metadata of the native denom in
+// its default genesis.
+type bankModuleBasic struct {
+	bank.AppModule
+}
+
+// DefaultGenesis returns the default bank genesis with the native denom
+// metadata.
+func (bankModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genState := banktypes.DefaultGenesisState()
+	genState.DenomMetadata = []banktypes.Metadata{NativeDenomMetadata()}
+	return cdc.MustMarshalJSON(genState)
+}
+
+// validateGenesisDenoms checks that the chain inited by InitChainer stakes,
+// mints and takes gov deposits in a single denom.
+func (app *ChainApp) validateGenesisDenoms(ctx sdk.Context) error {
+	stakingParams, err := app.StakingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	mintParams, err := app.MintKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	govParams, err := app.GovKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkNativeDenoms(stakingParams.BondDenom, mintParams.MintDenom, slices.Concat(govParams.MinDeposit, govParams.ExpeditedMinDeposit))
+}
+
+func checkNativeDenoms(bondDenom, mintDenom string, govDeposits sdk.Coins) error {
+	if mintDenom != bondDenom {
+		return fmt.Errorf("mint denom %s does not match the bond denom %s", mintDenom, bondDenom)
+	}
+	for _, coin := range govDeposits {
+		if coin.Denom != bondDenom {
+			return fmt.Errorf("gov deposit denom %s does not match the bond denom %s", coin.Denom, bondDenom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,518 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+)
+
+// NetworkSpec declares the genesis of an Outbe network. Anything it leaves
+// out keeps the value of DefaultGenesis, with every native denom of the
+// modules set to BaseDenom.
+type NetworkSpec struct {
+	ChainID string `json:"chain_id"`
+	// GenesisTime defaults to the time the genesis is built.
+	GenesisTime time.Time `json:"genesis_time"`
+	// InitialHeight defaults to 1.
+	InitialHeight int64         `json:"initial_height"`
+	Consensus     ConsensusSpec `json:"consensus"`
+	Accounts      []AccountSpec `json:"accounts"`
+	DenomMetadata DenomMetaSpec `json:"denom_metadata"`
+	// Params are merged into the params of the genesis state of each named
+	// module, e.g. {"gov": {"voting_period": "60s"}}.
+	Params map[string]json.RawMessage `json:"params"`
+	// GenTxsDir is the dir of the gentxs to collect, none if empty.
+	GenTxsDir string         `json:"gentxs_dir"`
+	WasmCodes []WasmCodeSpec `json:"wasm_codes"`
+}
+
+// ConsensusSpec overrides the default CometBFT consensus params.
+type ConsensusSpec struct {
+	MaxBytes                   *int64 `json:"max_bytes"`
+	MaxGas                     *int64 `json:"max_gas"`
+	VoteExtensionsEnableHeight *int64 `json:"vote_extensions_enable_height"`
+}
+
+// AccountSpec is a genesis account and its balance.
+type AccountSpec struct {
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
+	// Vesting locks part of the coins, nil for a plain account.
+	Vesting *VestingSpec `json:"vesting"`
+}
+
+// VestingSpec is the schedule of a vesting account. Periods make it a
+// periodic vesting account from Start, Start and End a continuous one and End
+// alone a delayed one.
+type VestingSpec struct {
+	Coins   string              `json:"coins"`
+	Start   time.Time           `json:"start"`
+	End     time.Time           `json:"end"`
+	Periods []VestingPeriodSpec `json:"periods"`
+}
+
+// VestingPeriodSpec is a period of a periodic vesting account. Length is a
+// duration like "720h".
+type VestingPeriodSpec struct {
+	Length string `json:"length"`
+	Coins  string `json:"coins"`
+}
+
+// DenomMetaSpec overrides the descriptive fields of the BaseDenom metadata.
+type DenomMetaSpec struct {
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+}
+
+// WasmCodeSpec is a wasm code stored at genesis, given by the path of its
+// optionally gzipped byte code.
+type WasmCodeSpec struct {
+	File string `json:"file"`
+	// Creator defaults to the gov module account.
+	Creator string `json:"creator"`
+	// InstantiatePermission is everybody, nobody or any_of, which lets the
+	// InstantiateAddresses instantiate the code. It defaults to the
+	// instantiate default permission of the wasm params.
+	InstantiatePermission string   `json:"instantiate_permission"`
+	InstantiateAddresses  []string `json:"instantiate_addresses"`
+	Pin                   bool     `json:"pin"`
+}
+
+// BuildGenesis builds the genesis of a network from its spec and validates it
+// with the genesis validation of every module. Relative paths of the spec are
+// relative to the working dir. It returns the persistent peers of the
+// collected gentxs along with the genesis.
+func (app *ChainApp) BuildGenesis(spec NetworkSpec) (*genutiltypes.AppGenesis, string, error) {
+	genesis := GenesisState(app.DefaultGenesis())
+
+	if err := app.addGenesisAccounts(genesis, spec.Accounts); err != nil {
+		return nil, "", err
+	}
+	if err := app.setGenesisDenomMetadata(genesis, spec.DenomMetadata); err != nil {
+		return nil, "", err
+	}
+	if err := mergeGenesisParams(genesis, spec.Params); err != nil {
+		return nil, "", err
+	}
+	if err := app.addGenesisWasmCodes(genesis, spec.WasmCodes); err != nil {
+		return nil, "", err
+	}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(spec.ChainID, nil)
+	appGenesis.GenesisTime = spec.GenesisTime
+	if appGenesis.GenesisTime.IsZero() {
+		appGenesis.GenesisTime = time.Now().UTC()
+	}
+	appGenesis.InitialHeight = spec.InitialHeight
+	if appGenesis.InitialHeight == 0 {
+		appGenesis.InitialHeight = 1
+	}
+	appGenesis.Consensus.Params = spec.Consensus.params()
+
+	var persistentPeers string
+	if spec.GenTxsDir != "" {
+		// the gentxs are checked against the balances of the genesis
+		appState, err := json.Marshal(genesis)
+		if err != nil {
+			return nil, "", err
+		}
+		appGenesis.AppState = appState
+
+		genTxs, peers, err := genutil.CollectTxs(
+			app.appCodec, app.txConfig.TxJSONDecoder(), "", spec.GenTxsDir, appGenesis,
+			banktypes.GenesisBalancesIterator{}, genutiltypes.DefaultMessageValidator,
+			app.txConfig.SigningContext().ValidatorAddressCodec(),
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("collect gentxs: %w", err)
+		}
+		if len(genTxs) == 0 {
+			return nil, "", fmt.Errorf("no gentxs in %s", spec.GenTxsDir)
+		}
+		if genesis, err = genutil.SetGenTxsInAppGenesisState(app.appCodec, app.txConfig.TxJSONEncoder(), genesis, genTxs); err != nil {
+			return nil, "", err
+		}
+		persistentPeers = peers
+	}
+
+	if err := app.BasicModuleManager.ValidateGenesis(app.appCodec, app.txConfig, genesis); err != nil {
+		return nil, "", fmt.Errorf("invalid genesis: %w", err)
+	}
+	// checked by InitChainer as well
+	if err := app.checkGenesisDenoms(genesis); err != nil {
+		return nil, "", fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	appState, err := json.MarshalIndent(genesis, "", " ")
+	if err != nil {
+		return nil, "", err
+	}
+	appGenesis.AppState = appState
+	if err := appGenesis.ValidateAndComplete(); err != nil {
+		return nil, "", fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	return appGenesis, persistentPeers, nil
+}
+
+func (app *ChainApp) checkGenesisDenoms(genesis GenesisState) error {
+	var mintState minttypes.GenesisState
+	if err := app.appCodec.UnmarshalJSON(genesis[minttypes.ModuleName], &mintState); err != nil {
+		return err
+	}
+	var govState govv1.GenesisState
+	if err := app.appCodec.UnmarshalJSON(genesis[govtypes.ModuleName], &govState); err != nil {
+		return err
+	}
+
+	bondDenom := stakingtypes.GetGenesisStateFromAppState(app.appCodec, genesis).Params.BondDenom
+	return checkNativeDenoms(bondDenom, mintState.Params.MintDenom, slices.Concat(govState.Params.MinDeposit, govState.Params.ExpeditedMinDeposit))
+}
+
+func (c ConsensusSpec) params() *cmttypes.ConsensusParams {
+	params := cmttypes.DefaultConsensusParams()
+	if c.MaxBytes != nil {
+		params.Block.MaxBytes = *c.MaxBytes
+	}
+	if c.MaxGas != nil {
+		params.Block.MaxGas = *c.MaxGas
+	}
+	if c.VoteExtensionsEnableHeight != nil {
+		params.ABCI.VoteExtensionsEnableHeight = *c.VoteExtensionsEnableHeight
+	}
+	return params
+}
+
+// updateModuleGenesis decodes the genesis state of a module into state, lets
+// update change it and encodes it back.
+func (app *ChainApp) updateModuleGenesis(genesis GenesisState, name string, state proto.Message, update func() error) error {
+	if err := app.appCodec.UnmarshalJSON(genesis[name], state); err != nil {
+		return fmt.Errorf("%s genesis: %w", name, err)
+	}
+	if err := update(); err != nil {
+		return fmt.Errorf("%s genesis: %w", name, err)
+	}
+
+	bz, err := app.appCodec.MarshalJSON(state)
+	if err != nil {
+		return fmt.Errorf("%s genesis: %w", name, err)
+	}
+	genesis[name] = bz
+	return nil
+}
+
+func (app *ChainApp) addGenesisAccounts(genesis GenesisState, specs []AccountSpec) error {
+	var accounts authtypes.GenesisAccounts
+	var balances []banktypes.Balance
+	for i, spec := range specs {
+		account, balance, err := spec.genesisAccount()
+		if err != nil {
+			return fmt.Errorf("account %d: %w", i, err)
+		}
+		accounts = append(accounts, account)
+		balances = append(balances, balance)
+	}
+
+	var authState authtypes.GenesisState
+	if err := app.updateModuleGenesis(genesis, authtypes.ModuleName, &authState, func() error {
+		existing, err := authtypes.UnpackAccounts(authState.Accounts)
+		if err != nil {
+			return err
+		}
+		existing = append(existing, accounts...)
+		if err := authtypes.ValidateGenAccounts(existing); err != nil {
+			return err
+		}
+
+		authState.Accounts, err = authtypes.PackAccounts(existing)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var bankState banktypes.GenesisState
+	return app.updateModuleGenesis(genesis, banktypes.ModuleName, &bankState, func() error {
+		bankState.Balances = banktypes.SanitizeGenesisBalances(append(bankState.Balances, balances...))
+
+		bankState.Supply = sdk.NewCoins()
+		for _, balance := range bankState.Balances {
+			bankState.Supply = bankState.Supply.Add(balance.Coins...)
+		}
+		return nil
+	})
+}
+
+func (spec AccountSpec) genesisAccount() (authtypes.GenesisAccount, banktypes.Balance, error) {
+	addr, err := sdk.AccAddressFromBech32(spec.Address)
+	if err != nil {
+		return nil, banktypes.Balance{}, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(spec.Coins)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("coins: %w", err)
+	}
+	balance := banktypes.Balance{Address: addr.String(), Coins: coins}
+
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	if spec.Vesting == nil {
+		return baseAccount, balance, nil
+	}
+
+	account, err := spec.Vesting.vestingAccount(baseAccount)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("vesting: %w", err)
+	}
+	if account.(vestingexported.VestingAccount).GetOriginalVesting().IsAnyGT(coins) {
+		return nil, banktypes.Balance{}, errors.New("vesting coins exceed the account coins")
+	}
+	return account, balance, account.Validate()
+}
+
+func (spec VestingSpec) vestingAccount(baseAccount *authtypes.BaseAccount) (authtypes.GenesisAccount, error) {
+	if len(spec.Periods) > 0 {
+		if spec.Start.IsZero() || !spec.End.IsZero() || spec.Coins != "" {
+			return nil, errors.New("periodic vesting takes a start and periods only")
+		}
+
+		periods := make(vestingtypes.Periods, len(spec.Periods))
+		var total sdk.Coins
+		for i, period := range spec.Periods {
+			length, err := time.ParseDuration(period.Length)
+			if err != nil || length <= 0 {
+				return nil, fmt.Errorf("period %d: invalid length %q", i, period.Length)
+			}
+			coins, err := sdk.ParseCoinsNormalized(period.Coins)
+			if err != nil {
+				return nil, fmt.Errorf("period %d: %w", i, err)
+			}
+			periods[i] = vestingtypes.Period{Length: int64(length / time.Second), Amount: coins}
+			total = total.Add(coins...)
+		}
+		return vestingtypes.NewPeriodicVestingAccount(baseAccount, total, spec.Start.Unix(), periods)
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(spec.Coins)
+	if err != nil {
+		return nil, fmt.Errorf("coins: %w", err)
+	}
+	if spec.End.IsZero() {
+		return nil, errors.New("vesting needs an end or periods")
+	}
+	if spec.Start.IsZero() {
+		return vestingtypes.NewDelayedVestingAccount(baseAccount, coins, spec.End.Unix())
+	}
+	return vestingtypes.NewContinuousVestingAccount(baseAccount, coins, spec.Start.Unix(), spec.End.Unix())
+}
+
+// setGenesisDenomMetadata sets the metadata of BaseDenom, overriding the
+// descriptive fields of NativeDenomMetadata the spec sets.
+func (app *ChainApp) setGenesisDenomMetadata(genesis GenesisState, spec DenomMetaSpec) error {
+	metadata := NativeDenomMetadata()
+	if spec.Name != "" {
+		metadata.Name = spec.Name
+	}
+	if spec.Symbol != "" {
+		metadata.Symbol = spec.Symbol
+	}
+	if spec.Description != "" {
+		metadata.Description = spec.Description
+	}
+	if spec.URI != "" {
+		metadata.URI = spec.URI
+	}
+	if err := metadata.Validate(); err != nil {
+		return fmt.Errorf("denom metadata: %w", err)
+	}
+
+	var bankState banktypes.GenesisState
+	return app.updateModuleGenesis(genesis, banktypes.ModuleName, &bankState, func() error {
+		for i, existing := range bankState.DenomMetadata {
+			if existing.Base == BaseDenom {
+				bankState.DenomMetadata[i] = metadata
+				return nil
+			}
+		}
+		bankState.DenomMetadata = append(bankState.DenomMetadata, metadata)
+		return nil
+	})
+}
+
+// mergeGenesisParams merges the given JSON objects into the params of the
+// genesis state of the named modules.
+func mergeGenesisParams(genesis GenesisState, params map[string]json.RawMessage) error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var state map[string]json.RawMessage
+		if err := json.Unmarshal(genesis[name], &state); err != nil || state == nil {
+			return fmt.Errorf("params: module %s does not exist", name)
+		}
+		current, ok := state["params"]
+		if !ok {
+			return fmt.Errorf("params: module %s has no params", name)
+		}
+
+		merged, err := mergeJSONObjects(current, params[name])
+		if err != nil {
+			return fmt.Errorf("params: %s: %w", name, err)
+		}
+		state["params"] = merged
+
+		if genesis[name], err = json.Marshal(state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeJSONObjects merges the fields of src into the JSON object dst,
+// recursing into the fields that are objects in both.
+func mergeJSONObjects(dst, src json.RawMessage) (json.RawMessage, error) {
+	var dstFields, srcFields map[string]json.RawMessage
+	if err := json.Unmarshal(dst, &dstFields); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(src, &srcFields); err != nil || srcFields == nil {
+		return nil, errors.New("must be an object")
+	}
+
+	for name, value := range srcFields {
+		current, ok := dstFields[name]
+		if ok && isJSONObject(current) && isJSONObject(value) {
+			merged, err := mergeJSONObjects(current, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			value = merged
+		}
+		dstFields[name] = value
+	}
+	return json.Marshal(dstFields)
+}
+
+func isJSONObject(bz json.RawMessage) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(bz), []byte("{"))
+}
+
+// addGenesisWasmCodes stores the codes with the ids following the codes of the
+// genesis.
+func (app *ChainApp) addGenesisWasmCodes(genesis GenesisState, specs []WasmCodeSpec) error {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	var wasmState wasmtypes.GenesisState
+	return app.updateModuleGenesis(genesis, wasmtypes.ModuleName, &wasmState, func() error {
+		var lastCodeID uint64
+		for _, code := range wasmState.Codes {
+			lastCodeID = max(lastCodeID, code.CodeID)
+		}
+
+		for i, spec := range specs {
+			code, err := spec.genesisCode(wasmState.Params)
+			if err != nil {
+				return fmt.Errorf("code %d: %w", i, err)
+			}
+			lastCodeID++
+			code.CodeID = lastCodeID
+			wasmState.Codes = append(wasmState.Codes, code)
+		}
+
+		for i, seq := range wasmState.Sequences {
+			if bytes.Equal(seq.IDKey, wasmtypes.KeySequenceCodeID) {
+				wasmState.Sequences[i].Value = max(seq.Value, lastCodeID+1)
+				return nil
+			}
+		}
+		wasmState.Sequences = append(wasmState.Sequences, wasmtypes.Sequence{IDKey: wasmtypes.KeySequenceCodeID, Value: lastCodeID + 1})
+		return nil
+	})
+}
+
+func (spec WasmCodeSpec) genesisCode(params wasmtypes.Params) (wasmtypes.Code, error) {
+	codeBytes, err := os.ReadFile(spec.File)
+	if err != nil {
+		return wasmtypes.Code{}, err
+	}
+	wasmCode := codeBytes
+	if ioutils.IsGzip(codeBytes) {
+		if wasmCode, err = ioutils.Uncompress(codeBytes, math.MaxInt64); err != nil {
+			return wasmtypes.Code{}, fmt.Errorf("%s: %w", spec.File, err)
+		}
+	}
+	checksum, err := wasmvm.CreateChecksum(wasmCode)
+	if err != nil {
+		return wasmtypes.Code{}, fmt.Errorf("%s: %w", spec.File, err)
+	}
+
+	creator := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if spec.Creator != "" {
+		if creator, err = sdk.AccAddressFromBech32(spec.Creator); err != nil {
+			return wasmtypes.Code{}, fmt.Errorf("creator: %w", err)
+		}
+	}
+
+	// like a code stored without a permission
+	permission := params.InstantiateDefaultPermission.With(creator)
+	switch spec.InstantiatePermission {
+	case "":
+	case "everybody":
+		permission = wasmtypes.AllowEverybody
+	case "nobody":
+		permission = wasmtypes.AllowNobody
+	case "any_of":
+		addrs := make([]sdk.AccAddress, len(spec.InstantiateAddresses))
+		for i, addr := range spec.InstantiateAddresses {
+			if addrs[i], err = sdk.AccAddressFromBech32(addr); err != nil {
+				return wasmtypes.Code{}, fmt.Errorf("instantiate address %s: %w", addr, err)
+			}
+		}
+		permission = wasmtypes.AccessTypeAnyOfAddresses.With(addrs...)
+	default:
+		return wasmtypes.Code{}, fmt.Errorf("unknown instantiate permission %s", spec.InstantiatePermission)
+	}
+	if spec.InstantiatePermission != "any_of" && len(spec.InstantiateAddresses) > 0 {
+		return wasmtypes.Code{}, errors.New("instantiate addresses need the any_of instantiate permission")
+	}
+
+	return wasmtypes.Code{
+		CodeInfo: wasmtypes.CodeInfo{
+			CodeHash:          checksum,
+			Creator:           creator.String(),
+			InstantiateConfig: permission,
+		},
+		CodeBytes: codeBytes,
+		Pinned:    spec.Pin,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestBuildGenesis(t *testing.T) {
+	chainApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+
+	validatorKey := secp256k1.GenPrivKey()
+	validator := sdk.AccAddress(validatorKey.PubKey().Address())
+	delayed := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	periodic := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a gentx of the validator account
+	selfDelegation := sdk.NewCoin(BaseDenom, sdk.DefaultPowerReduction)
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(validator).String(), ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("validator", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec()),
+		sdkmath.OneInt(),
+	)
+	require.NoError(t, err)
+	genTx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)), chainApp.TxConfig(), []sdk.Msg{createValidator}, sdk.NewCoins(), simtestutil.DefaultGenTxGas, "builder-1", []uint64{0}, []uint64{0}, validatorKey)
+	require.NoError(t, err)
+	genTxBz, err := chainApp.TxConfig().TxJSONEncoder()(genTx)
+	require.NoError(t, err)
+	genTxsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(genTxsDir, "gentx-validator.json"), genTxBz, 0o600))
+
+	maxGas := int64(100000000)
+	spec := NetworkSpec{
+		ChainID:     "builder-1",
+		GenesisTime: start,
+		Consensus:   ConsensusSpec{MaxGas: &maxGas},
+		Accounts: []AccountSpec{
+			{Address: validator.String(), Coins: "10000000000000000000" + BaseDenom},
+			{
+				Address: delayed.String(),
+				Coins:   "1000" + BaseDenom,
+				Vesting: &VestingSpec{Coins: "400" + BaseDenom, End: start.Add(time.Hour)},
+			},
+			{
+				Address: periodic.String(),
+				Coins:   "1000" + BaseDenom,
+				Vesting: &VestingSpec{Start: start, Periods: []VestingPeriodSpec{
+					{Length: "1h", Coins: "100" + BaseDenom},
+					{Length: "24h", Coins: "200" + BaseDenom},
+				}},
+			},
+		},
+		DenomMetadata: DenomMetaSpec{Description: "The native token"},
+		Params: map[string]json.RawMessage{
+			"gov":     json.RawMessage(`{"voting_period":"60s","expedited_voting_period":"30s","min_deposit":[{"denom":"unit","amount":"10"}]}`),
+			"staking": json.RawMessage(`{"min_commission_rate":"0.050000000000000000"}`),
+		},
+		GenTxsDir: genTxsDir,
+		WasmCodes: []WasmCodeSpec{{
+			File:                  "../interchaintest/contracts/cw_template.wasm",
+			InstantiatePermission: "any_of",
+			InstantiateAddresses:  []string{validator.String()},
+			Pin:                   true,
+		}},
+	}
+
+	appGenesis, persistentPeers, err := chainApp.BuildGenesis(spec)
+	require.NoError(t, err)
+	require.Equal(t, genTx.(sdk.TxWithMemo).GetMemo(), persistentPeers)
+	require.Equal(t, maxGas, appGenesis.Consensus.Params.Block.MaxGas)
+
+	// a chain inits from the genesis
+	newApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil, baseapp.SetChainID(spec.ChainID))
+	consensusParams := appGenesis.Consensus.Params.ToProto()
+	res, err := newApp.InitChain(&abci.RequestInitChain{
+		Time:            appGenesis.GenesisTime,
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   appGenesis.InitialHeight,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	_, err = newApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: start})
+	require.NoError(t, err)
+	_, err = newApp.Commit()
+	require.NoError(t, err)
+	ctx := newApp.NewContext(true)
+
+	require.IsType(t, &vestingtypes.DelayedVestingAccount{}, newApp.AccountKeeper.GetAccount(ctx, delayed))
+	periodicAccount, ok := newApp.AccountKeeper.GetAccount(ctx, periodic).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, start.Add(25*time.Hour).Unix(), periodicAccount.EndTime)
+
+	stakingParams, err := newApp.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, BaseDenom, stakingParams.BondDenom)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(5, 2), stakingParams.MinCommissionRate)
+
+	govParams, err := newApp.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, *govParams.VotingPeriod)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 10)), sdk.Coins(govParams.MinDeposit))
+
+	metadata, found := newApp.BankKeeper.GetDenomMetaData(ctx, BaseDenom)
+	require.True(t, found)
+	require.Equal(t, DisplayDenom, metadata.Display)
+	require.Equal(t, "The native token", metadata.Description)
+
+	codeInfo := newApp.WasmKeeper.GetCodeInfo(ctx, 1)
+	require.NotNil(t, codeInfo)
+	require.Equal(t, wasmtypes.AccessTypeAnyOfAddresses.With(validator), codeInfo.InstantiateConfig)
+	require.True(t, newApp.WasmKeeper.IsPinnedCode(ctx, 1))
+}
+
+func TestBuildGenesisErrors(t *testing.T) {
+	chainApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	for name, tc := range map[string]struct {
+		spec NetworkSpec
+		err  string
+	}{
+		"no chain id": {
+			spec: NetworkSpec{},
+			err:  "chain_id",
+		},
+		"duplicate account": {
+			spec: NetworkSpec{ChainID: "builder-1", Accounts: []AccountSpec{{Address: addr, Coins: "1unit"}, {Address: addr, Coins: "1unit"}}},
+			err:  "duplicate account",
+		},
+		"vesting more than the coins": {
+			spec: NetworkSpec{ChainID: "builder-1", Accounts: []AccountSpec{{Address: addr, Coins: "1unit", Vesting: &VestingSpec{Coins: "2unit", End: time.Now()}}}},
+			err:  "vesting coins exceed",
+		},
+		"unknown module": {
+			spec: NetworkSpec{ChainID: "builder-1", Params: map[string]json.RawMessage{"nope": json.RawMessage(`{}`)}},
+			err:  "module nope does not exist",
+		},
+		"unknown param": {
+			spec: NetworkSpec{ChainID: "builder-1", Params: map[string]json.RawMessage{"gov": json.RawMessage(`{"voting_periods":"60s"}`)}},
+			err:  "voting_periods",
+		},
+		"invalid param": {
+			spec: NetworkSpec{ChainID: "builder-1", Params: map[string]json.RawMessage{"staking": json.RawMessage(`{"bond_denom":""}`)}},
+			err:  "invalid genesis",
+		},
+		"missing gentxs": {
+			spec: NetworkSpec{ChainID: "builder-1", GenTxsDir: t.TempDir()},
+			err:  "no gentxs",
+		},
+		"invalid wasm": {
+			spec: NetworkSpec{ChainID: "builder-1", WasmCodes: []WasmCodeSpec{{File: "genesis_builder.go"}}},
+			err:  "code 0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := chainApp.BuildGenesis(tc.spec)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	// the denoms default to the native one
+	appGenesis, _, err := chainApp.BuildGenesis(NetworkSpec{ChainID: "builder-1"})
+	require.NoError(t, err)
+	var genesis GenesisState
+	require.NoError(t, json.Unmarshal(appGenesis.AppState, &genesis))
+	bankState := banktypes.GetGenesisStateFromAppState(chainApp.AppCodec(), genesis)
+	require.Len(t, bankState.DenomMetadata, 1)
+	require.Equal(t, DisplayDenom, bankState.DenomMetadata[0].Name)
+	require.Equal(t, BaseDenom, stakingtypes.GetGenesisStateFromAppState(chainApp.AppCodec(), genesis).Params.BondDenom)
+}
@@ -0,0 +1,515 @@
+package app
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// StreamedAppStateKey is the only app state key of a genesis whose module
+// states are kept in one file per module instead of in the genesis file.
+const StreamedAppStateKey = "streamed_app_state"
+
+// StreamedAppStateDir is the dir a streaming export writes the module files
+// to, relative to its output dir.
+const StreamedAppStateDir = "app_state"
+
+// wasmContractStateFile is the file a streaming export writes the state of
+// the wasm contracts to.
+const wasmContractStateFile = "wasm_contract_state.json"
+
+// StreamedAppState lists the files holding the genesis state of each module.
+type StreamedAppState struct {
+	// Dir is the dir of the module files, relative to the config dir of the
+	// node home unless absolute.
+	Dir     string           `json:"dir"`
+	Modules []StreamedModule `json:"modules"`
+	// WasmContractState is the file holding the state of the wasm contracts,
+	// left out of the wasm module file. It is a stream of
+	// WasmContractModel, read one model at a time.
+	WasmContractState *StreamedFile `json:"wasm_contract_state,omitempty"`
+}
+
+// StreamedModule is the file holding the genesis state of a module.
+type StreamedModule struct {
+	Name string `json:"name"`
+	StreamedFile
+}
+
+// StreamedFile is a file of a streamed app state.
+type StreamedFile struct {
+	File string `json:"file"`
+	// SHA256 is the hex encoded hash of the file. Every node of the chain
+	// must init from the same state, so a file that does not match it is
+	// rejected.
+	SHA256 string `json:"sha256"`
+}
+
+// WasmContractModel is a key of the state of a wasm contract.
+type WasmContractModel struct {
+	ContractAddress string            `json:"contract_address"`
+	Key             cmtbytes.HexBytes `json:"key"`
+	Value           []byte            `json:"value"`
+}
+
+// moduleFiles checks the files of the streamed app state and returns them by
+// module name.
+func (s StreamedAppState) moduleFiles(hasModule func(name string) bool) (map[string]StreamedModule, error) {
+	modules := make(map[string]StreamedModule, len(s.Modules))
+	for _, m := range s.Modules {
+		if !hasModule(m.Name) {
+			return nil, fmt.Errorf("%s: module %s does not exist", StreamedAppStateKey, m.Name)
+		}
+		if _, ok := modules[m.Name]; ok {
+			return nil, fmt.Errorf("%s: duplicate module %s", StreamedAppStateKey, m.Name)
+		}
+		if !isFileName(m.File) {
+			return nil, fmt.Errorf("%s: module %s file %q is not a file name", StreamedAppStateKey, m.Name, m.File)
+		}
+		modules[m.Name] = m
+	}
+
+	if s.WasmContractState != nil {
+		if _, ok := modules[wasmtypes.ModuleName]; !ok {
+			return nil, fmt.Errorf("%s: wasm contract state without the %s module", StreamedAppStateKey, wasmtypes.ModuleName)
+		}
+		if !isFileName(s.WasmContractState.File) {
+			return nil, fmt.Errorf("%s: wasm contract state file %q is not a file name", StreamedAppStateKey, s.WasmContractState.File)
+		}
+	}
+
+	return modules, nil
+}
+
+func isFileName(file string) bool {
+	return file != "" && filepath.Base(file) == file
+}
+
+// ExportAppStateAndValidatorsToDir exports the state of the application like
+// ExportAppStateAndValidators, but writes the genesis state of each module to
+// its own file in outDir/app_state as soon as it is exported, so the whole
+// state is never held in memory at once. The state of the wasm contracts is
+// written to a file of its own one key at a time. The returned app state is
+// the StreamedAppState listing the files, along with the state changes made
+// to prepare a zero height export.
+func (app *ChainApp) ExportAppStateAndValidatorsToDir(forZeroHeight bool, jailAllowedAddrs, modulesToExport []string, outDir string) (servertypes.ExportedApp, ZeroHeightReport, error) {
+	if len(modulesToExport) == 0 {
+		modulesToExport = app.ModuleManager.OrderExportGenesis
+	}
+	for _, name := range modulesToExport {
+		if _, ok := app.ModuleManager.Modules[name]; !ok {
+			return servertypes.ExportedApp{}, ZeroHeightReport{}, fmt.Errorf("module %s does not exist", name)
+		}
+	}
+
+	ctx, height, report, err := app.exportContext(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	dir := filepath.Join(outDir, StreamedAppStateDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	streamed := StreamedAppState{Dir: StreamedAppStateDir}
+	for _, name := range modulesToExport {
+		if name == wasmtypes.ModuleName {
+			m, contractState, err := app.exportWasmGenesis(ctx, dir)
+			if err != nil {
+				return servertypes.ExportedApp{}, report, fmt.Errorf("genesis export error in %s: %w", name, err)
+			}
+
+			streamed.Modules = append(streamed.Modules, m)
+			streamed.WasmContractState = &contractState
+			continue
+		}
+
+		genState, err := app.ModuleManager.ExportGenesisForModules(ctx, app.appCodec, []string{name})
+		if err != nil {
+			return servertypes.ExportedApp{}, report, err
+		}
+
+		bz, ok := genState[name]
+		if !ok {
+			continue
+		}
+
+		file := name + ".json"
+		sum, err := writeModuleGenesis(filepath.Join(dir, file), bz)
+		if err != nil {
+			return servertypes.ExportedApp{}, report, fmt.Errorf("genesis export error in %s: %w", name, err)
+		}
+
+		streamed.Modules = append(streamed.Modules, StreamedModule{Name: name, StreamedFile: StreamedFile{File: file, SHA256: sum}})
+	}
+
+	appState, err := json.MarshalIndent(map[string]StreamedAppState{StreamedAppStateKey: streamed}, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, report, err
+	}
+
+	exported, err := app.exportedApp(ctx, height, appState)
+	return exported, report, err
+}
+
+// writeModuleGenesis writes the genesis state of a module to path and returns
+// its hex encoded hash.
+func writeModuleGenesis(path string, bz json.RawMessage) (string, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.MultiWriter(f, h).Write(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), f.Close()
+}
+
+// exportWasmGenesis writes the genesis state of the wasm module without the
+// state of the contracts to dir, then the state of the contracts to a file of
+// its own, one key at a time. It mirrors wasmkeeper.ExportGenesis, which
+// holds the state of every contract in memory.
+func (app *ChainApp) exportWasmGenesis(ctx sdk.Context, dir string) (StreamedModule, StreamedFile, error) {
+	genState := wasmtypes.GenesisState{Params: app.WasmKeeper.GetParams(ctx)}
+
+	var err error
+	app.WasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmtypes.CodeInfo) bool {
+		var bytecode []byte
+		bytecode, err = app.WasmKeeper.GetByteCode(ctx, codeID)
+		genState.Codes = append(genState.Codes, wasmtypes.Code{
+			CodeID:    codeID,
+			CodeInfo:  info,
+			CodeBytes: bytecode,
+			Pinned:    app.WasmKeeper.IsPinnedCode(ctx, codeID),
+		})
+		return err != nil
+	})
+	if err != nil {
+		return StreamedModule{}, StreamedFile{}, err
+	}
+
+	app.WasmKeeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, contract wasmtypes.ContractInfo) bool {
+		genState.Contracts = append(genState.Contracts, wasmtypes.Contract{
+			ContractAddress:     addr.String(),
+			ContractInfo:        contract,
+			ContractCodeHistory: app.WasmKeeper.GetContractHistory(ctx, addr),
+		})
+		return false
+	})
+
+	for _, k := range [][]byte{wasmtypes.KeySequenceCodeID, wasmtypes.KeySequenceInstanceID} {
+		id, err := app.WasmKeeper.PeekAutoIncrementID(ctx, k)
+		if err != nil {
+			return StreamedModule{}, StreamedFile{}, err
+		}
+		genState.Sequences = append(genState.Sequences, wasmtypes.Sequence{IDKey: k, Value: id})
+	}
+
+	bz, err := app.appCodec.MarshalJSON(&genState)
+	if err != nil {
+		return StreamedModule{}, StreamedFile{}, err
+	}
+
+	m := StreamedModule{Name: wasmtypes.ModuleName, StreamedFile: StreamedFile{File: wasmtypes.ModuleName + ".json"}}
+	if m.SHA256, err = writeModuleGenesis(filepath.Join(dir, m.File), bz); err != nil {
+		return StreamedModule{}, StreamedFile{}, err
+	}
+
+	contractState := StreamedFile{File: wasmContractStateFile}
+	if contractState.SHA256, err = app.writeWasmContractState(ctx, filepath.Join(dir, contractState.File), genState.Contracts); err != nil {
+		return StreamedModule{}, StreamedFile{}, err
+	}
+
+	return m, contractState, nil
+}
+
+// writeWasmContractState writes the state of the contracts to path, one
+// WasmContractModel per line, and returns its hex encoded hash.
+func (app *ChainApp) writeWasmContractState(ctx sdk.Context, path string, contracts []wasmtypes.Contract) (string, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(f, h))
+	enc := json.NewEncoder(w)
+	for _, contract := range contracts {
+		addr, err := sdk.AccAddressFromBech32(contract.ContractAddress)
+		if err != nil {
+			return "", err
+		}
+
+		app.WasmKeeper.IterateContractState(ctx, addr, func(key, value []byte) bool {
+			err = enc.Encode(WasmContractModel{ContractAddress: contract.ContractAddress, Key: key, Value: value})
+			return err != nil
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), f.Close()
+}
+
+// readWasmContractState decodes the models of the contract state file one at
+// a time and passes them to fn. The hash of the file is checked once it is
+// read through, so fn may have been called with the models of a file that is
+// rejected.
+func readWasmContractState(dir string, file StreamedFile, fn func(WasmContractModel) error) error {
+	f, err := os.Open(filepath.Join(dir, file.File))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	dec := json.NewDecoder(io.TeeReader(bufio.NewReader(f), h))
+	for {
+		var m WasmContractModel
+		if err := dec.Decode(&m); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%s: %w", file.File, err)
+		}
+
+		if err := (wasmtypes.Model{Key: m.Key, Value: m.Value}).ValidateBasic(); err != nil {
+			return fmt.Errorf("%s: contract %s: %w", file.File, m.ContractAddress, err)
+		}
+		if err := fn(m); err != nil {
+			return fmt.Errorf("%s: contract %s: %w", file.File, m.ContractAddress, err)
+		}
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != file.SHA256 {
+		return fmt.Errorf("%s: sha256 %s does not match the genesis %s", file.File, sum, file.SHA256)
+	}
+
+	return nil
+}
+
+// importWasmContractState sets the state of the contracts the wasm module was
+// inited with from the contract state file, like wasmkeeper.InitGenesis does
+// with the contract state of its genesis.
+func (app *ChainApp) importWasmContractState(ctx sdk.Context, dir string, file StreamedFile) error {
+	store := ctx.KVStore(app.GetKey(wasmtypes.StoreKey))
+	return readWasmContractState(dir, file, func(m WasmContractModel) error {
+		addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+		if err != nil {
+			return err
+		}
+		if !app.WasmKeeper.HasContractInfo(ctx, addr) {
+			return wasmtypes.ErrNoSuchContractFn(m.ContractAddress)
+		}
+
+		contractStore := prefix.NewStore(store, wasmtypes.GetContractStorePrefix(addr))
+		if contractStore.Has(m.Key) {
+			return errorsmod.Wrapf(wasmtypes.ErrDuplicate, "duplicate key: %x", m.Key)
+		}
+		if m.Value == nil {
+			m.Value = []byte{}
+		}
+		contractStore.Set(m.Key, m.Value)
+		return nil
+	})
+}
+
+// readModuleGenesis reads the genesis state of a module, checking it against
+// its hash.
+func readModuleGenesis(dir string, m StreamedModule) (json.RawMessage, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, m.File))
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(bz)
+	if hex.EncodeToString(sum[:]) != m.SHA256 {
+		return nil, fmt.Errorf("%s: sha256 %x does not match the genesis %s", m.File, sum, m.SHA256)
+	}
+
+	return bz, nil
+}
+
+// parseStreamedAppState returns the streamed app state of genesisState and
+// the dir of its files, resolved against configDir.
+func parseStreamedAppState(genesisState GenesisState, configDir string) (StreamedAppState, string, error) {
+	if len(genesisState) != 1 {
+		return StreamedAppState{}, "", fmt.Errorf("%s must be the only app state key", StreamedAppStateKey)
+	}
+
+	var streamed StreamedAppState
+	if err := json.Unmarshal(genesisState[StreamedAppStateKey], &streamed); err != nil {
+		return StreamedAppState{}, "", fmt.Errorf("%s: %w", StreamedAppStateKey, err)
+	}
+
+	dir := streamed.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(configDir, dir)
+	}
+
+	return streamed, dir, nil
+}
+
+// ValidateStreamedGenesis validates a streamed app state like
+// module.BasicManager.ValidateGenesis, reading the files of the modules one
+// at a time from its dir, resolved against configDir. The contract state file
+// is checked to only hold the state of the contracts of the wasm module file.
+func ValidateStreamedGenesis(basicManager module.BasicManager, cdc codec.JSONCodec, txConfig client.TxEncodingConfig, genesisState GenesisState, configDir string) error {
+	streamed, dir, err := parseStreamedAppState(genesisState, configDir)
+	if err != nil {
+		return err
+	}
+
+	modules, err := streamed.moduleFiles(func(name string) bool {
+		_, ok := basicManager[name]
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+
+	for name, b := range basicManager {
+		mod, ok := b.(module.HasGenesisBasics)
+		if !ok {
+			continue
+		}
+
+		// like a module missing from the app state, a module without a file
+		// is validated with no state
+		var genState json.RawMessage
+		if m, ok := modules[name]; ok {
+			if genState, err = readModuleGenesis(dir, m); err != nil {
+				return fmt.Errorf("genesis of %s: %w", name, err)
+			}
+		}
+
+		if err := mod.ValidateGenesis(cdc, txConfig, genState); err != nil {
+			return err
+		}
+	}
+
+	if streamed.WasmContractState == nil {
+		return nil
+	}
+
+	bz, err := readModuleGenesis(dir, modules[wasmtypes.ModuleName])
+	if err != nil {
+		return err
+	}
+	var wasmGenesis wasmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &wasmGenesis); err != nil {
+		return err
+	}
+
+	contracts := make(map[string]bool, len(wasmGenesis.Contracts))
+	for _, contract := range wasmGenesis.Contracts {
+		contracts[contract.ContractAddress] = true
+	}
+
+	return readWasmContractState(dir, *streamed.WasmContractState, func(m WasmContractModel) error {
+		if !contracts[m.ContractAddress] {
+			return wasmtypes.ErrNoSuchContractFn(m.ContractAddress)
+		}
+		return nil
+	})
+}
+
+// initStreamedGenesis inits the modules in the InitGenesis order of the module
+// manager from the files of the streamed app state, reading one module file
+// at a time.
+func (app *ChainApp) initStreamedGenesis(ctx sdk.Context, genesisState GenesisState) (*abci.ResponseInitChain, error) {
+	streamed, dir, err := parseStreamedAppState(genesisState, filepath.Join(app.homePath, "config"))
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := streamed.moduleFiles(func(name string) bool {
+		_, ok := app.ModuleManager.Modules[name]
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.Logger().Info("initializing blockchain state from streamed genesis", "dir", dir)
+
+	// mirrors module.Manager.InitGenesis, which needs the state of all
+	// modules up front
+	var validatorUpdates []abci.ValidatorUpdate
+	for _, name := range app.ModuleManager.OrderInitGenesis {
+		m, ok := modules[name]
+		if !ok {
+			continue
+		}
+
+		genState, err := readModuleGenesis(dir, m)
+		if err != nil {
+			return nil, fmt.Errorf("genesis of %s: %w", name, err)
+		}
+
+		ctx.Logger().Debug("running initialization for module", "module", name)
+		switch mod := app.ModuleManager.Modules[name].(type) {
+		case appmodule.HasGenesis:
+			source, err := genesis.SourceFromRawJSON(genState)
+			if err != nil {
+				return nil, err
+			}
+			if err := mod.InitGenesis(ctx, source); err != nil {
+				return nil, err
+			}
+		case module.HasGenesis:
+			mod.InitGenesis(ctx, app.appCodec, genState)
+		case module.HasABCIGenesis:
+			moduleValUpdates := mod.InitGenesis(ctx, app.appCodec, genState)
+			if len(moduleValUpdates) > 0 {
+				if len(validatorUpdates) > 0 {
+					return nil, errors.New("validator InitGenesis updates already set by a previous module")
+				}
+				validatorUpdates = moduleValUpdates
+			}
+		}
+
+		if name == wasmtypes.ModuleName && streamed.WasmContractState != nil {
+			if err := app.importWasmContractState(ctx, dir, *streamed.WasmContractState); err != nil {
+				return nil, fmt.Errorf("genesis of %s: %w", name, err)
+			}
+		}
+	}
+
+	if len(validatorUpdates) == 0 {
+		return nil, fmt.Errorf("validator set is empty after InitGenesis, please ensure at least one validator is initialized with a delegation greater than or equal to the DefaultPowerReduction (%d)", sdk.DefaultPowerReduction)
+	}
+
+	return &abci.ResponseInitChain{Validators: validatorUpdates}, nil
+}
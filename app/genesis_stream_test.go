@@ -0,0 +1,148 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamedGenesisExportImport(t *testing.T) {
+	gapp := NewChainAppWithCustomOptions(t, false, SetupOptions{
+		Logger:  log.NewTestLogger(t).With("instance", "first"),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	_, err := gapp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.NoError(t, err)
+
+	// a contract whose state is exported to the contract state file
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{Height: 1, Time: time.Unix(1, 0).UTC()})
+	code, err := os.ReadFile("../interchaintest/contracts/cw_template.wasm")
+	require.NoError(t, err)
+	creator := sdk.AccAddress("creator_____________")
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&gapp.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, creator, code, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, creator, nil, []byte(`{"count":1}`), "template", nil)
+	require.NoError(t, err)
+
+	_, err = gapp.Commit()
+	require.NoError(t, err)
+
+	// exported by the app that stored the code, whose wasm VM holds it
+	exportApp := gapp
+
+	exported, err := exportApp.ExportAppStateAndValidators(false, []string{}, nil)
+	require.NoError(t, err)
+
+	// the module files are read from the config dir of the node home
+	streamedHome := t.TempDir()
+	streamed, _, err := exportApp.ExportAppStateAndValidatorsToDir(false, []string{}, nil, filepath.Join(streamedHome, "config"))
+	require.NoError(t, err)
+	require.Equal(t, exported.Height, streamed.Height)
+	require.Equal(t, exported.Validators, streamed.Validators)
+
+	var genState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genState))
+
+	var appState map[string]StreamedAppState
+	require.NoError(t, json.Unmarshal(streamed.AppState, &appState))
+	manifest := appState[StreamedAppStateKey]
+	require.Len(t, manifest.Modules, len(genState))
+
+	// the contract state is left out of the wasm module file
+	var wasmGenesis wasmtypes.GenesisState
+	require.NoError(t, exportApp.AppCodec().UnmarshalJSON(genState[wasmtypes.ModuleName], &wasmGenesis))
+	require.Len(t, wasmGenesis.Contracts, 1)
+	contractState := wasmGenesis.Contracts[0].ContractState
+	require.NotEmpty(t, contractState)
+	wasmGenesis.Contracts[0].ContractState = nil
+	genState[wasmtypes.ModuleName] = exportApp.AppCodec().MustMarshalJSON(&wasmGenesis)
+
+	dir := filepath.Join(streamedHome, "config", manifest.Dir)
+	for _, m := range manifest.Modules {
+		bz, err := readModuleGenesis(dir, m)
+		require.NoError(t, err)
+		require.JSONEq(t, string(genState[m.Name]), string(bz), m.Name)
+	}
+
+	require.NotNil(t, manifest.WasmContractState)
+	var models []wasmtypes.Model
+	require.NoError(t, readWasmContractState(dir, *manifest.WasmContractState, func(m WasmContractModel) error {
+		require.Equal(t, contract.String(), m.ContractAddress)
+		models = append(models, wasmtypes.Model{Key: m.Key, Value: m.Value})
+		return nil
+	}))
+	require.Equal(t, contractState, models)
+
+	var streamedGenState GenesisState
+	require.NoError(t, json.Unmarshal(streamed.AppState, &streamedGenState))
+	require.NoError(t, ValidateStreamedGenesis(exportApp.BasicModuleManager, exportApp.AppCodec(), exportApp.TxConfig(), streamedGenState, filepath.Join(streamedHome, "config")))
+
+	// both genesis files init the same state, once the indented app state of
+	// the export no longer indents the messages of the contract histories
+	var compacted bytes.Buffer
+	require.NoError(t, json.Compact(&compacted, exported.AppState))
+	exported.AppState = compacted.Bytes()
+	appHash := initAndFinalize(t, t.TempDir(), exported)
+	require.NotEmpty(t, appHash)
+	require.Equal(t, appHash, initAndFinalize(t, streamedHome, streamed))
+
+	// a module file that does not match the genesis is rejected
+	bankFile := filepath.Join(dir, "bank.json")
+	bz, err := os.ReadFile(bankFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(bankFile, append(bz, ' '), 0o600))
+	require.ErrorContains(t, ValidateStreamedGenesis(exportApp.BasicModuleManager, exportApp.AppCodec(), exportApp.TxConfig(), streamedGenState, filepath.Join(streamedHome, "config")), "does not match the genesis")
+
+	// the wasm VMs of the first import still lock the data dir of its home
+	tamperedHome := t.TempDir()
+	require.NoError(t, os.Symlink(filepath.Join(streamedHome, "config"), filepath.Join(tamperedHome, "config")))
+
+	_, err = newStreamedImportApp(t, tamperedHome).InitChain(initChainRequest(streamed))
+	require.ErrorContains(t, err, "does not match the genesis")
+}
+
+func newStreamedImportApp(t *testing.T, home string) *ChainApp {
+	t.Helper()
+	return NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(home), nil)
+}
+
+func initChainRequest(exported servertypes.ExportedApp) *abci.RequestInitChain {
+	return &abci.RequestInitChain{
+		Time:            time.Unix(0, 0).UTC(),
+		InitialHeight:   exported.Height,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+	}
+}
+
+// initAndFinalize inits a new app with the exported state and returns the app
+// hash of its first block.
+func initAndFinalize(t *testing.T, home string, exported servertypes.ExportedApp) []byte {
+	t.Helper()
+
+	app := newStreamedImportApp(t, home)
+	_, err := app.InitChain(initChainRequest(exported))
+	require.NoError(t, err)
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: exported.Height, Time: time.Unix(1, 0).UTC()})
+	require.NoError(t, err)
+	return res.AppHash
+}
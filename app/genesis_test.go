@@ -0,0 +1,104 @@
+package app
+
+import (
+	"encoding/json"
+	"maps"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestNativeDenomMetadata(t *testing.T) {
+	metadata := NativeDenomMetadata()
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, BaseDenom, metadata.Base)
+	require.Equal(t, DisplayDenom, metadata.Display)
+	require.Equal(t, uint32(BaseDenomUnit), metadata.DenomUnits[1].Exponent)
+
+	app := Setup(t)
+	ctx := app.NewContext(true)
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, BaseDenom)
+	require.True(t, found)
+	require.Equal(t, metadata, stored)
+
+	stakingParams, err := app.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, BaseDenom, stakingParams.BondDenom)
+}
+
+func TestInitChainerDenoms(t *testing.T) {
+	chainApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	acc := authtypes.NewBaseAccount(sdk.AccAddress(pubKey.Address()), nil, 0, 0)
+	genesis, err := GenesisStateWithValSet(chainApp.AppCodec(), chainApp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc})
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		module string
+		state  func(GenesisState) json.RawMessage
+		err    string
+	}{
+		"mint denom": {
+			module: minttypes.ModuleName,
+			state: func(genesis GenesisState) json.RawMessage {
+				var state minttypes.GenesisState
+				chainApp.AppCodec().MustUnmarshalJSON(genesis[minttypes.ModuleName], &state)
+				state.Params.MintDenom = "other"
+				return chainApp.AppCodec().MustMarshalJSON(&state)
+			},
+			err: "mint denom other does not match the bond denom " + BaseDenom,
+		},
+		"gov deposit denom": {
+			module: govtypes.ModuleName,
+			state: func(genesis GenesisState) json.RawMessage {
+				var state govv1.GenesisState
+				chainApp.AppCodec().MustUnmarshalJSON(genesis[govtypes.ModuleName], &state)
+				state.Params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewInt64Coin("other", 1))
+				return chainApp.AppCodec().MustMarshalJSON(&state)
+			},
+			err: "gov deposit denom other does not match the bond denom " + BaseDenom,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mismatched := maps.Clone(genesis)
+			mismatched[tc.module] = tc.state(genesis)
+			appState, err := json.Marshal(mismatched)
+			require.NoError(t, err)
+
+			newApp := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil, baseapp.SetChainID(chainID))
+			_, err = newApp.InitChain(&abci.RequestInitChain{
+				ChainId:         chainID,
+				ConsensusParams: simtestutil.DefaultConsensusParams,
+				AppStateBytes:   appState,
+			})
+			require.ErrorContains(t, err, tc.err)
+
+			_, _, err = chainApp.BuildGenesis(NetworkSpec{ChainID: chainID, Params: map[string]json.RawMessage{
+				tc.module: extractParams(t, mismatched[tc.module]),
+			}})
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func extractParams(t *testing.T, state json.RawMessage) json.RawMessage {
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(state, &fields))
+	return fields["params"]
+}
@@ -0,0 +1,242 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// setupCoordinator returns an IBC coordinator of n chains running ChainApp.
+func setupCoordinator(t *testing.T, n int) *ibctesting.Coordinator {
+	t.Helper()
+
+	init := ibctesting.DefaultTestingAppInit
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = init })
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app := NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+		return app, app.DefaultGenesis()
+	}
+
+	return ibctesting.NewCoordinator(t, n)
+}
+
+// newFeeTransferPath returns a transfer path whose channel is fee enabled.
+func newFeeTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewTransferPath(chainA, chainB)
+
+	version := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{
+		FeeVersion: ibcfeetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+
+	return path
+}
+
+type feeTransferTest struct {
+	coord          *ibctesting.Coordinator
+	chainA, chainB *ibctesting.TestChain
+	path           *ibctesting.Path
+
+	sender              sdk.AccAddress
+	recvPayee, ackPayee sdk.AccAddress
+	fee                 ibcfeetypes.Fee
+}
+
+// setupFeeTransfer opens a fee enabled transfer channel between the first two
+// of n chains and registers the payees of their relayers.
+func setupFeeTransfer(t *testing.T, n int) *feeTransferTest {
+	t.Helper()
+
+	coord := setupCoordinator(t, n)
+	chainA, chainB := coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2))
+	path := newFeeTransferPath(chainA, chainB)
+	coord.Setup(path)
+
+	ft := &feeTransferTest{
+		coord:     coord,
+		chainA:    chainA,
+		chainB:    chainB,
+		path:      path,
+		sender:    chainA.SenderAccount.GetAddress(),
+		recvPayee: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		ackPayee:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		fee: ibcfeetypes.NewFee(
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)),
+		),
+	}
+	require.True(t, chainA.App.(*ChainApp).IBCFeeKeeper.IsFeeEnabled(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	// the relayer of chain B is paid the receive fee on chain A, the relayer
+	// of chain A the ack and timeout fees
+	_, err := chainB.SendMsgs(ibcfeetypes.NewMsgRegisterCounterpartyPayee(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		chainB.SenderAccount.GetAddress().String(), ft.recvPayee.String(),
+	))
+	require.NoError(t, err)
+	_, err = chainA.SendMsgs(ibcfeetypes.NewMsgRegisterPayee(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		chainA.SenderAccount.GetAddress().String(), ft.ackPayee.String(),
+	))
+	require.NoError(t, err)
+
+	return ft
+}
+
+// transfer pays the relayer fees and sends amount to chain B in one tx.
+func (ft *feeTransferTest) transfer(t *testing.T, amount int64, timeout clienttypes.Height, memo string) channeltypes.Packet {
+	t.Helper()
+
+	endpoint := ft.path.EndpointA
+	res, err := ft.chainA.SendMsgs(
+		ibcfeetypes.NewMsgPayPacketFee(ft.fee, endpoint.ChannelConfig.PortID, endpoint.ChannelID, ft.sender.String(), nil),
+		transfertypes.NewMsgTransfer(
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			ft.sender.String(), ft.chainB.SenderAccount.GetAddress().String(),
+			timeout, 0, memo,
+		),
+	)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	return packet
+}
+
+func (ft *feeTransferTest) balance(chain *ibctesting.TestChain, addr sdk.AccAddress) sdkmath.Int {
+	return chain.App.(*ChainApp).BankKeeper.GetBalance(chain.GetContext(), addr, sdk.DefaultBondDenom).Amount
+}
+
+func (ft *feeTransferTest) escrowed() sdkmath.Int {
+	return ft.balance(ft.chainA, authtypes.NewModuleAddress(ibcfeetypes.ModuleName))
+}
+
+func TestFeeEnabledTransfer(t *testing.T) {
+	ft := setupFeeTransfer(t, 2)
+	before := ft.balance(ft.chainA, ft.sender)
+
+	packet := ft.transfer(t, 1000, clienttypes.NewHeight(1, 110), "")
+
+	// the fees are escrowed until the packet lifecycle completes
+	require.Equal(t, ft.fee.Total().AmountOf(sdk.DefaultBondDenom), ft.escrowed())
+	require.Equal(t, before.SubRaw(1000).Sub(ft.fee.Total().AmountOf(sdk.DefaultBondDenom)), ft.balance(ft.chainA, ft.sender))
+
+	_, ack, err := ft.path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	var incentivized ibcfeetypes.IncentivizedAcknowledgement
+	require.NoError(t, json.Unmarshal(ack, &incentivized))
+	require.True(t, incentivized.UnderlyingAppSuccess)
+	require.Equal(t, ft.recvPayee.String(), incentivized.ForwardRelayerAddress)
+
+	// receive and ack fees paid out, timeout fee refunded
+	require.True(t, ft.escrowed().IsZero())
+	require.Equal(t, sdkmath.NewInt(100), ft.balance(ft.chainA, ft.recvPayee))
+	require.Equal(t, sdkmath.NewInt(50), ft.balance(ft.chainA, ft.ackPayee))
+	require.Equal(t, before.SubRaw(1000+100+50), ft.balance(ft.chainA, ft.sender))
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		ft.path.EndpointB.ChannelConfig.PortID, ft.path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	require.Equal(t, sdkmath.NewInt(1000), ft.chainB.App.(*ChainApp).BankKeeper.GetBalance(ft.chainB.GetContext(), ft.chainB.SenderAccount.GetAddress(), voucher).Amount)
+}
+
+func TestFeeEnabledTransferTimeout(t *testing.T) {
+	ft := setupFeeTransfer(t, 2)
+	before := ft.balance(ft.chainA, ft.sender)
+
+	timeout := clienttypes.NewHeight(1, uint64(ft.chainB.CurrentHeader.Height)+1)
+	packet := ft.transfer(t, 1000, timeout, "")
+	require.Equal(t, ft.fee.Total().AmountOf(sdk.DefaultBondDenom), ft.escrowed())
+
+	ft.coord.CommitNBlocks(ft.chainB, 3)
+	require.NoError(t, ft.path.EndpointA.UpdateClient())
+	require.NoError(t, ft.path.EndpointA.TimeoutPacket(packet))
+
+	// the transfer and the receive and ack fees are refunded, the timeout fee
+	// paid out
+	require.True(t, ft.escrowed().IsZero())
+	require.True(t, ft.balance(ft.chainA, ft.recvPayee).IsZero())
+	require.Equal(t, sdkmath.NewInt(25), ft.balance(ft.chainA, ft.ackPayee))
+	require.Equal(t, before.SubRaw(25), ft.balance(ft.chainA, ft.sender))
+}
+
+// Packets forwarded by PFM are acknowledged asynchronously. Their acks must
+// go through the fee middleware like synchronous ones, or the sender chain
+// cannot pay the relayers.
+func TestFeeEnabledForward(t *testing.T) {
+	ft := setupFeeTransfer(t, 3)
+	chainC := ft.coord.GetChain(ibctesting.GetChainID(3))
+	pathBC := newFeeTransferPath(ft.chainB, chainC)
+	ft.coord.Setup(pathBC)
+
+	receiver := chainC.SenderAccount.GetAddress().String()
+	memo, err := json.Marshal(map[string]any{
+		"forward": map[string]any{
+			"receiver": receiver,
+			"port":     pathBC.EndpointA.ChannelConfig.PortID,
+			"channel":  pathBC.EndpointA.ChannelID,
+		},
+	})
+	require.NoError(t, err)
+
+	packet := ft.transfer(t, 1000, clienttypes.NewHeight(1, 110), string(memo))
+
+	// chain B receives the packet and forwards it without acknowledging it
+	require.NoError(t, ft.path.EndpointB.UpdateClient())
+	res, err := ft.path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	require.Error(t, err)
+	forwarded, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	// chain C receives the forwarded packet, its ack on chain B completes the
+	// original packet
+	require.NoError(t, pathBC.EndpointB.UpdateClient())
+	res, err = pathBC.EndpointB.RecvPacketWithResult(forwarded)
+	require.NoError(t, err)
+	ackC, err := ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+
+	proof, proofHeight := chainC.QueryProof(host.PacketAcknowledgementKey(forwarded.GetDestPort(), forwarded.GetDestChannel(), forwarded.GetSequence()))
+	res, err = ft.chainB.SendMsgs(channeltypes.NewMsgAcknowledgement(forwarded, ackC, proof, proofHeight, ft.chainB.SenderAccount.GetAddress().String()))
+	require.NoError(t, err)
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	require.NoError(t, err)
+
+	var incentivized ibcfeetypes.IncentivizedAcknowledgement
+	require.NoError(t, json.Unmarshal(ack, &incentivized))
+	require.True(t, incentivized.UnderlyingAppSuccess)
+	require.Equal(t, ft.recvPayee.String(), incentivized.ForwardRelayerAddress)
+
+	require.NoError(t, ft.path.EndpointA.UpdateClient())
+	require.NoError(t, ft.path.EndpointA.AcknowledgePacket(packet, ack))
+
+	require.True(t, ft.escrowed().IsZero())
+	require.Equal(t, sdkmath.NewInt(100), ft.balance(ft.chainA, ft.recvPayee))
+	require.Equal(t, sdkmath.NewInt(50), ft.balance(ft.chainA, ft.ackPayee))
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	poakeeper "github.com/outbe/outbe-node/x/poa/keeper"
+	poatypes "github.com/outbe/outbe-node/x/poa/types"
+)
+
+func TestPoAValidatorPower(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	ms := poakeeper.NewMsgServerImpl(gapp.PoAKeeper)
+
+	require.NoError(t, gapp.PoAKeeper.SetParams(ctx, poatypes.NewParams(true)))
+
+	bondDenom, err := gapp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	supplyBefore := gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount
+
+	pubKey, err := codectypes.NewAnyWithValue(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	_, err = ms.AddValidator(ctx, &poatypes.MsgAddValidator{
+		Sender:           gov,
+		ValidatorAddress: valAddr.String(),
+		Pubkey:           pubKey,
+		Description:      stakingtypes.Description{Moniker: "poa"},
+		Power:            10,
+	})
+	require.NoError(t, err)
+
+	requirePower := func(power int64) {
+		t.Helper()
+
+		val, err := gapp.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction), val.Tokens)
+		require.Equal(t, supplyBefore.Add(val.Tokens), gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+	}
+	requirePower(10)
+
+	for _, power := range []int64{25, 5} {
+		_, err = ms.SetPower(ctx, &poatypes.MsgSetPower{Sender: gov, ValidatorAddress: valAddr.String(), Power: power})
+		require.NoError(t, err)
+		requirePower(power)
+	}
+
+	_, err = ms.RemoveValidator(ctx, &poatypes.MsgRemoveValidator{Sender: gov, ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+
+	// the validator was never bonded, so it is deleted right away and its
+	// stake is burned
+	_, err = gapp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+	require.Equal(t, supplyBefore, gapp.BankKeeper.GetSupply(ctx, bondDenom).Amount)
+
+	// the genesis validator holds all bonded power and cannot be removed
+	validators, err := gapp.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	_, err = ms.RemoveValidator(ctx, &poatypes.MsgRemoveValidator{Sender: gov, ValidatorAddress: validators[0].GetOperator()})
+	require.ErrorIs(t, err, poatypes.ErrLastValidator)
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// KVDiff is a key whose value differs between two versions of a store. A nil
+// value means the key is missing from that version.
+type KVDiff struct {
+	Key    []byte
+	ValueA []byte
+	ValueB []byte
+}
+
+// DiffKVStores returns the keys whose values differ between a and b in key
+// order. A nil store is empty. With a positive limit it stops after limit
+// differences and reports whether there are more.
+func DiffKVStores(a, b types.KVStore, limit int) (diffs []KVDiff, more bool, err error) {
+	iterA, err := storeIterator(a)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iterA.Close()
+
+	iterB, err := storeIterator(b)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var d KVDiff
+		switch {
+		case !iterB.Valid() || (iterA.Valid() && bytes.Compare(iterA.Key(), iterB.Key()) < 0):
+			d = KVDiff{Key: iterA.Key(), ValueA: iterA.Value()}
+			iterA.Next()
+		case !iterA.Valid() || bytes.Compare(iterA.Key(), iterB.Key()) > 0:
+			d = KVDiff{Key: iterB.Key(), ValueB: iterB.Value()}
+			iterB.Next()
+		default:
+			d = KVDiff{Key: iterA.Key(), ValueA: iterA.Value(), ValueB: iterB.Value()}
+			iterA.Next()
+			iterB.Next()
+			if bytes.Equal(d.ValueA, d.ValueB) {
+				continue
+			}
+		}
+
+		if limit > 0 && len(diffs) == limit {
+			return diffs, true, nil
+		}
+		diffs = append(diffs, d)
+	}
+
+	if err := iterA.Error(); err != nil {
+		return diffs, false, err
+	}
+	return diffs, false, iterB.Error()
+}
+
+// emptyIterator is the iterator of a missing store.
+type emptyIterator struct{}
+
+func (emptyIterator) Domain() ([]byte, []byte) { return nil, nil }
+func (emptyIterator) Valid() bool              { return false }
+func (emptyIterator) Next()                    {}
+func (emptyIterator) Key() []byte              { return nil }
+func (emptyIterator) Value() []byte            { return nil }
+func (emptyIterator) Error() error             { return nil }
+func (emptyIterator) Close() error             { return nil }
+
+func storeIterator(store types.KVStore) (types.Iterator, error) {
+	if store == nil {
+		return emptyIterator{}, nil
+	}
+
+	iter := store.Iterator(nil, nil)
+	return iter, iter.Error()
+}
+
+// DecodeKVDiff returns a human readable form of a difference in the named
+// store, using the store decoder the module registered with the simulation
+// manager, or hex if it has none or cannot decode the key.
+func (app *ChainApp) DecodeKVDiff(storeName string, d KVDiff) (decoded string) {
+	hexDiff := fmt.Sprintf("A: %X\nB: %X", d.ValueA, d.ValueB)
+
+	decoder, ok := app.SimulationManager().StoreDecoders[storeName]
+	if !ok {
+		return hexDiff
+	}
+
+	// decoders panic on keys they do not know
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = hexDiff
+		}
+	}()
+
+	return decoder(kv.Pair{Key: d.Key, Value: d.ValueA}, kv.Pair{Key: d.Key, Value: d.ValueB})
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/dbadapter"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDiffKVStores(t *testing.T) {
+	a, b := dbadapter.Store{DB: dbm.NewMemDB()}, dbadapter.Store{DB: dbm.NewMemDB()}
+	a.Set([]byte{1}, []byte("same"))
+	b.Set([]byte{1}, []byte("same"))
+	a.Set([]byte{2}, []byte("only a"))
+	a.Set([]byte{3}, []byte("a"))
+	b.Set([]byte{3}, []byte("b"))
+	b.Set([]byte{4}, []byte("only b"))
+
+	diffs, more, err := DiffKVStores(a, b, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Equal(t, []KVDiff{
+		{Key: []byte{2}, ValueA: []byte("only a")},
+		{Key: []byte{3}, ValueA: []byte("a"), ValueB: []byte("b")},
+		{Key: []byte{4}, ValueB: []byte("only b")},
+	}, diffs)
+
+	diffs, more, err = DiffKVStores(a, b, 2)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, diffs, 2)
+
+	diffs, more, err = DiffKVStores(a, a, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Empty(t, diffs)
+
+	// a missing store is empty
+	diffs, _, err = DiffKVStores(nil, b, 0)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+	require.Nil(t, diffs[0].ValueA)
+}
+
+func TestDecodeKVDiff(t *testing.T) {
+	app := Setup(t)
+
+	// the bank decoder decodes the supply collection
+	supplyKey := append(banktypes.SupplyKey.Bytes(), []byte("uoutbe")...)
+	decoded := app.DecodeKVDiff(banktypes.StoreKey, KVDiff{Key: supplyKey, ValueA: []byte("1000"), ValueB: []byte("2000")})
+	require.Contains(t, decoded, "1000")
+	require.Contains(t, decoded, "2000")
+
+	// keys the decoder does not know fall back to hex
+	decoded = app.DecodeKVDiff(banktypes.StoreKey, KVDiff{Key: []byte{0xff}, ValueA: []byte{0xab}})
+	require.Equal(t, "A: AB\nB: ", decoded)
+
+	decoded = app.DecodeKVDiff("no-decoder", KVDiff{Key: []byte{1}, ValueB: []byte{0xcd}})
+	require.Equal(t, "A: \nB: CD", decoded)
+}
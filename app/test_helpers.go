@@ -426,8 +426,9 @@ func GenesisStateWithValSet(
 		totalSupply = totalSupply.Add(b.Coins...)
 	}
 
-	// update total supply
-	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}, []banktypes.SendEnabled{})
+	// update total supply, keeping the denom metadata of the genesis
+	denomMetadata := banktypes.GetGenesisStateFromAppState(codec, genesisState).DenomMetadata
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, denomMetadata, []banktypes.SendEnabled{})
 	genesisState[banktypes.ModuleName] = codec.MustMarshalJSON(bankGenesis)
 
 	return genesisState, nil
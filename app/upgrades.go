@@ -2,42 +2,51 @@ package app
 
 import (
 	"fmt"
+	"time"
 
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
 	"github.com/outbe/outbe-node/app/upgrades"
 	"github.com/outbe/outbe-node/app/upgrades/noop"
+	"github.com/outbe/outbe-node/app/upgrades/pos"
+	v2 "github.com/outbe/outbe-node/app/upgrades/v2"
 )
 
 // Upgrades list of chain upgrades
-var Upgrades = []upgrades.Upgrade{}
+var Upgrades = []upgrades.Upgrade{
+	v2.NewUpgrade(),
+	pos.NewUpgrade(),
+}
 
 // RegisterUpgradeHandlers registers the chain upgrade handlers
 func (app *ChainApp) RegisterUpgradeHandlers() {
 	// setupLegacyKeyTables(&app.ParamsKeeper)
-	if len(Upgrades) == 0 {
+	if app.Version() != "" && !hasUpgrade(app.Version()) {
 		// always have a unique upgrade registered for the current version to test in system tests
 		Upgrades = append(Upgrades, noop.NewUpgrade(app.Version()))
 	}
 
-	keepers := upgrades.AppKeepers{
-		AccountKeeper:         &app.AccountKeeper,
-		ParamsKeeper:          &app.ParamsKeeper,
-		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
-		CapabilityKeeper:      app.CapabilityKeeper,
-		IBCKeeper:             app.IBCKeeper,
-		Codec:                 app.appCodec,
-		GetStoreKey:           app.GetKey,
+	if err := app.validateUpgrades(); err != nil {
+		panic(err)
 	}
 
+	keepers := app.upgradeKeepers()
+
 	// register all upgrade handlers
 	for _, upgrade := range Upgrades {
 		app.UpgradeKeeper.SetUpgradeHandler(
 			upgrade.UpgradeName,
-			upgrade.CreateUpgradeHandler(
+			upgrade.Handler(
 				app.ModuleManager,
 				app.configurator,
-				&keepers,
+				keepers,
 			),
 		)
 	}
@@ -59,3 +68,175 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		}
 	}
 }
+
+// upgradeKeepers returns the keepers handed to the upgrade handlers.
+func (app *ChainApp) upgradeKeepers() *upgrades.AppKeepers {
+	return &upgrades.AppKeepers{
+		AccountKeeper:         &app.AccountKeeper,
+		BankKeeper:            &app.BankKeeper,
+		CapabilityKeeper:      app.CapabilityKeeper,
+		StakingKeeper:         app.StakingKeeper,
+		SlashingKeeper:        &app.SlashingKeeper,
+		MintKeeper:            &app.MintKeeper,
+		DistrKeeper:           &app.DistrKeeper,
+		GovKeeper:             &app.GovKeeper,
+		CrisisKeeper:          app.CrisisKeeper,
+		UpgradeKeeper:         app.UpgradeKeeper,
+		ParamsKeeper:          &app.ParamsKeeper,
+		AuthzKeeper:           &app.AuthzKeeper,
+		EvidenceKeeper:        &app.EvidenceKeeper,
+		FeeGrantKeeper:        &app.FeeGrantKeeper,
+		GroupKeeper:           &app.GroupKeeper,
+		NFTKeeper:             &app.NFTKeeper,
+		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
+		CircuitKeeper:         &app.CircuitKeeper,
+		IBCKeeper:             app.IBCKeeper,
+		IBCFeeKeeper:          &app.IBCFeeKeeper,
+		ICAControllerKeeper:   &app.ICAControllerKeeper,
+		ICAHostKeeper:         &app.ICAHostKeeper,
+		TransferKeeper:        &app.TransferKeeper,
+		WasmKeeper:            &app.WasmKeeper,
+		PacketForwardKeeper:   app.PacketForwardKeeper,
+		WasmClientKeeper:      &app.WasmClientKeeper,
+		RatelimitKeeper:       &app.RatelimitKeeper,
+		IBCHooksKeeper:        &app.IBCHooksKeeper,
+		MsgFilterKeeper:       &app.MsgFilterKeeper,
+		TokenFactoryKeeper:    &app.TokenFactoryKeeper,
+		TokenHooksKeeper:      &app.TokenHooksKeeper,
+		PoAKeeper:             &app.PoAKeeper,
+		GlobalFeeKeeper:       &app.GlobalFeeKeeper,
+		TxFeesKeeper:          &app.TxFeesKeeper,
+		ThrottleKeeper:        &app.ThrottleKeeper,
+		ICAAuthKeeper:         &app.ICAAuthKeeper,
+		ICAHostPolicyKeeper:   &app.ICAHostPolicyKeeper,
+		RatePolicyKeeper:      &app.RatePolicyKeeper,
+		ForwardingKeeper:      &app.ForwardingKeeper,
+		ContractQueryKeeper:   &app.ContractQueryKeeper,
+		Codec:                 app.appCodec,
+		GetStoreKey:           app.GetKey,
+	}
+}
+
+// validateUpgrades checks the registered upgrades against the stores mounted
+// by this binary, so that a misconfigured upgrade fails at startup rather than
+// at the upgrade height.
+func (app *ChainApp) validateUpgrades() error {
+	seen := make(map[string]bool, len(Upgrades))
+	for _, upgrade := range Upgrades {
+		if err := upgrade.Validate(); err != nil {
+			return err
+		}
+
+		if seen[upgrade.UpgradeName] {
+			return fmt.Errorf("upgrade %s is registered more than once", upgrade.UpgradeName)
+		}
+		seen[upgrade.UpgradeName] = true
+
+		for _, name := range upgrade.StoreUpgrades.Added {
+			if app.GetKey(name) == nil {
+				return fmt.Errorf("upgrade %s adds store %s which is not mounted", upgrade.UpgradeName, name)
+			}
+		}
+		for _, name := range upgrade.StoreUpgrades.Deleted {
+			if app.GetKey(name) != nil {
+				return fmt.Errorf("upgrade %s deletes store %s which is still mounted", upgrade.UpgradeName, name)
+			}
+		}
+		for _, r := range upgrade.StoreUpgrades.Renamed {
+			if app.GetKey(r.OldKey) != nil || app.GetKey(r.NewKey) == nil {
+				return fmt.Errorf("upgrade %s renames store %s to %s which is not mounted", upgrade.UpgradeName, r.OldKey, r.NewKey)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetUpgrade returns the registered upgrade with the given name.
+func GetUpgrade(name string) (upgrades.Upgrade, bool) {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return upgrade, true
+		}
+	}
+
+	return upgrades.Upgrade{}, false
+}
+
+// UpgradeDryRunResult reports the outcome of DryRunUpgrade.
+type UpgradeDryRunResult struct {
+	Name           string
+	Height         int64
+	FromVersions   module.VersionMap
+	ToVersions     module.VersionMap
+	GasConsumed    uint64
+	UpgradeTime    time.Duration
+	InvariantsTime time.Duration
+}
+
+// DryRunUpgrade applies the named upgrade handler on top of the latest
+// committed state as if it ran in the next block, then asserts all registered
+// invariants. All writes go to a cache that is discarded, nothing is
+// committed. Store upgrades are applied by the store loader when the app
+// loads its state.
+func (app *ChainApp) DryRunUpgrade(name string) (UpgradeDryRunResult, error) {
+	res := UpgradeDryRunResult{Name: name, Height: app.LastBlockHeight() + 1}
+
+	if !app.UpgradeKeeper.HasHandler(name) {
+		return res, fmt.Errorf("upgrade %s is not registered", name)
+	}
+
+	now := time.Now().UTC()
+	ctx := app.NewUncachedContext(false, cmtproto.Header{ChainID: app.ChainID(), Height: res.Height, Time: now}).
+		WithHeaderInfo(header.Info{ChainID: app.ChainID(), Height: res.Height, Time: now}).
+		WithMultiStore(app.CommitMultiStore().CacheMultiStore()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+
+	cp, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	if err != nil {
+		return res, fmt.Errorf("failed to read consensus params: %w", err)
+	}
+	ctx = ctx.WithConsensusParams(cp)
+
+	if res.FromVersions, err = app.UpgradeKeeper.GetModuleVersionMap(ctx); err != nil {
+		return res, err
+	}
+
+	start := time.Now()
+	err = app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: name, Height: res.Height})
+	res.UpgradeTime = time.Since(start)
+	res.GasConsumed = ctx.GasMeter().GasConsumed()
+	if err != nil {
+		return res, fmt.Errorf("upgrade %s failed: %w", name, err)
+	}
+
+	if res.ToVersions, err = app.UpgradeKeeper.GetModuleVersionMap(ctx); err != nil {
+		return res, err
+	}
+
+	start = time.Now()
+	err = assertInvariants(ctx, app)
+	res.InvariantsTime = time.Since(start)
+
+	return res, err
+}
+
+// assertInvariants runs all registered invariants, turning the crisis
+// keeper's panic into an error.
+func assertInvariants(ctx sdk.Context, app *ChainApp) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invariant broken: %v", r)
+		}
+	}()
+
+	app.CrisisKeeper.AssertInvariants(ctx)
+	return nil
+}
+
+// hasUpgrade reports whether an upgrade with the given name is registered.
+func hasUpgrade(name string) bool {
+	_, ok := GetUpgrade(name)
+	return ok
+}
@@ -0,0 +1,9 @@
+// Package upgrades defines the chain's named software upgrades.
+//
+// Each upgrade lives in its own package under app/upgrades, named after the
+// upgrade (e.g. app/upgrades/pos for "poa-to-pos"), and exposes a
+// NewUpgrade constructor returning an Upgrade. The upgrade declares the stores
+// it adds, renames or deletes, its handler, and optional checks run right
+// before and after the handler. New upgrades are appended to app.Upgrades and
+// covered by a test in app/upgrades_test.go.
+package upgrades
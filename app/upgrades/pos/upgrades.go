@@ -0,0 +1,75 @@
+package pos
+
+import (
+	"context"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/outbe/outbe-node/app/upgrades"
+)
+
+// UpgradeName switches a permissioned chain back to proof-of-stake.
+const UpgradeName = "poa-to-pos"
+
+// NewUpgrade constructor
+func NewUpgrade() upgrades.Upgrade {
+	return upgrades.Upgrade{
+		UpgradeName:          UpgradeName,
+		CreateUpgradeHandler: CreateUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added:   []string{},
+			Deleted: []string{},
+		},
+		PreUpgradeCheck:  PreUpgradeCheck,
+		PostUpgradeCheck: PostUpgradeCheck,
+	}
+}
+
+// PreUpgradeCheck requires a bonded validator set to hand over to
+// proof-of-stake.
+func PreUpgradeCheck(ctx context.Context, ak *upgrades.AppKeepers) error {
+	validators, err := ak.StakingKeeper.GetLastValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(validators) == 0 {
+		return errors.New("no bonded validators")
+	}
+
+	return nil
+}
+
+// PostUpgradeCheck requires x/poa to be disabled without admins.
+func PostUpgradeCheck(ctx context.Context, ak *upgrades.AppKeepers) error {
+	params := ak.PoAKeeper.GetParams(ctx)
+	if params.Enabled || len(params.Admins) != 0 {
+		return errors.New("poa is still enabled")
+	}
+
+	return nil
+}
+
+// CreateUpgradeHandler disables x/poa. The validators keep their current
+// self-delegations as stake and anyone may create validators and delegate
+// from the upgrade height on.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		params := ak.PoAKeeper.GetParams(ctx)
+		params.Enabled = false
+		params.Admins = []string{}
+		if err := ak.PoAKeeper.SetParams(ctx, params); err != nil {
+			return nil, err
+		}
+
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
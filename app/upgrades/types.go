@@ -2,28 +2,104 @@ package upgrades
 
 import (
 	"context"
+	"fmt"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/keeper"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	wasmlckeeper "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	icahostkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	tokenfactorykeeper "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/keeper"
 
 	storetypes "cosmossdk.io/store/types"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	contractquerykeeper "github.com/outbe/outbe-node/x/contractquery/keeper"
+	forwardingkeeper "github.com/outbe/outbe-node/x/forwarding/keeper"
+	globalfeekeeper "github.com/outbe/outbe-node/x/globalfee/keeper"
+	icaauthkeeper "github.com/outbe/outbe-node/x/icaauth/keeper"
+	icahostpolicykeeper "github.com/outbe/outbe-node/x/icahostpolicy/keeper"
+	msgfilterkeeper "github.com/outbe/outbe-node/x/msgfilter/keeper"
+	poakeeper "github.com/outbe/outbe-node/x/poa/keeper"
+	ratepolicykeeper "github.com/outbe/outbe-node/x/ratepolicy/keeper"
+	throttlekeeper "github.com/outbe/outbe-node/x/throttle/keeper"
+	tokenhookskeeper "github.com/outbe/outbe-node/x/tokenhooks/keeper"
+	txfeeskeeper "github.com/outbe/outbe-node/x/txfees/keeper"
 )
 
+// AppKeepers exposes every keeper of the app to the upgrade handlers.
 type AppKeepers struct {
 	AccountKeeper         *authkeeper.AccountKeeper
+	BankKeeper            *bankkeeper.BaseKeeper
+	CapabilityKeeper      *capabilitykeeper.Keeper
+	StakingKeeper         *stakingkeeper.Keeper
+	SlashingKeeper        *slashingkeeper.Keeper
+	MintKeeper            *mintkeeper.Keeper
+	DistrKeeper           *distrkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
 	ParamsKeeper          *paramskeeper.Keeper
+	AuthzKeeper           *authzkeeper.Keeper
+	EvidenceKeeper        *evidencekeeper.Keeper
+	FeeGrantKeeper        *feegrantkeeper.Keeper
+	GroupKeeper           *groupkeeper.Keeper
+	NFTKeeper             *nftkeeper.Keeper
 	ConsensusParamsKeeper *consensusparamkeeper.Keeper
-	Codec                 codec.Codec
-	GetStoreKey           func(storeKey string) *storetypes.KVStoreKey
-	CapabilityKeeper      *capabilitykeeper.Keeper
-	IBCKeeper             *ibckeeper.Keeper
+	CircuitKeeper         *circuitkeeper.Keeper
+
+	IBCKeeper           *ibckeeper.Keeper
+	IBCFeeKeeper        *ibcfeekeeper.Keeper
+	ICAControllerKeeper *icacontrollerkeeper.Keeper
+	ICAHostKeeper       *icahostkeeper.Keeper
+	TransferKeeper      *ibctransferkeeper.Keeper
+
+	WasmKeeper          *wasmkeeper.Keeper
+	PacketForwardKeeper *packetforwardkeeper.Keeper
+	WasmClientKeeper    *wasmlckeeper.Keeper
+	RatelimitKeeper     *ratelimitkeeper.Keeper
+	IBCHooksKeeper      *ibchookskeeper.Keeper
+	MsgFilterKeeper     *msgfilterkeeper.Keeper
+	TokenFactoryKeeper  *tokenfactorykeeper.Keeper
+	TokenHooksKeeper    *tokenhookskeeper.Keeper
+	PoAKeeper           *poakeeper.Keeper
+	GlobalFeeKeeper     *globalfeekeeper.Keeper
+	TxFeesKeeper        *txfeeskeeper.Keeper
+	ThrottleKeeper      *throttlekeeper.Keeper
+	ICAAuthKeeper       *icaauthkeeper.Keeper
+	ICAHostPolicyKeeper *icahostpolicykeeper.Keeper
+	RatePolicyKeeper    *ratepolicykeeper.Keeper
+	ForwardingKeeper    *forwardingkeeper.Keeper
+	ContractQueryKeeper *contractquerykeeper.Keeper
+
+	Codec       codec.Codec
+	GetStoreKey func(storeKey string) *storetypes.KVStoreKey
 }
 type ModuleManager interface {
 	RunMigrations(ctx context.Context, cfg module.Configurator, fromVM module.VersionMap) (module.VersionMap, error)
@@ -40,5 +116,85 @@ type Upgrade struct {
 
 	// CreateUpgradeHandler defines the function that creates an upgrade handler
 	CreateUpgradeHandler func(ModuleManager, module.Configurator, *AppKeepers) upgradetypes.UpgradeHandler
-	StoreUpgrades        storetypes.StoreUpgrades
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	// They are applied when the new binary loads the store at the upgrade height.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// PreUpgradeCheck optionally asserts the state the upgrade expects before
+	// the handler runs. An error aborts the upgrade.
+	PreUpgradeCheck func(ctx context.Context, keepers *AppKeepers) error
+	// PostUpgradeCheck optionally asserts the migrated state after the
+	// handler ran. An error aborts the upgrade.
+	PostUpgradeCheck func(ctx context.Context, keepers *AppKeepers) error
+}
+
+// Validate checks that the upgrade is well formed.
+func (u Upgrade) Validate() error {
+	if u.UpgradeName == "" {
+		return fmt.Errorf("upgrade name must not be empty")
+	}
+
+	if u.CreateUpgradeHandler == nil {
+		return fmt.Errorf("upgrade %s: handler must be set", u.UpgradeName)
+	}
+
+	seen := make(map[string]bool)
+	check := func(name string) error {
+		if name == "" {
+			return fmt.Errorf("upgrade %s: store name must not be empty", u.UpgradeName)
+		}
+		if seen[name] {
+			return fmt.Errorf("upgrade %s: store %s is changed more than once", u.UpgradeName, name)
+		}
+		seen[name] = true
+		return nil
+	}
+
+	for _, name := range u.StoreUpgrades.Added {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+	for _, name := range u.StoreUpgrades.Deleted {
+		if err := check(name); err != nil {
+			return err
+		}
+	}
+	for _, r := range u.StoreUpgrades.Renamed {
+		if err := check(r.OldKey); err != nil {
+			return err
+		}
+		if err := check(r.NewKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Handler returns the upgrade handler wrapped with the pre and post upgrade
+// checks.
+func (u Upgrade) Handler(mm ModuleManager, configurator module.Configurator, keepers *AppKeepers) upgradetypes.UpgradeHandler {
+	handler := u.CreateUpgradeHandler(mm, configurator, keepers)
+
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if u.PreUpgradeCheck != nil {
+			if err := u.PreUpgradeCheck(ctx, keepers); err != nil {
+				return nil, fmt.Errorf("upgrade %s: pre-upgrade check: %w", u.UpgradeName, err)
+			}
+		}
+
+		vm, err := handler(ctx, plan, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		if u.PostUpgradeCheck != nil {
+			if err := u.PostUpgradeCheck(ctx, keepers); err != nil {
+				return nil, fmt.Errorf("upgrade %s: post-upgrade check: %w", u.UpgradeName, err)
+			}
+		}
+
+		return vm, nil
+	}
 }
@@ -0,0 +1,106 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/outbe/outbe-node/app/upgrades"
+	contractquerytypes "github.com/outbe/outbe-node/x/contractquery/types"
+	forwardingtypes "github.com/outbe/outbe-node/x/forwarding/types"
+	globalfeetypes "github.com/outbe/outbe-node/x/globalfee/types"
+	icaauthtypes "github.com/outbe/outbe-node/x/icaauth/types"
+	icahostpolicytypes "github.com/outbe/outbe-node/x/icahostpolicy/types"
+	msgfiltertypes "github.com/outbe/outbe-node/x/msgfilter/types"
+	poatypes "github.com/outbe/outbe-node/x/poa/types"
+	ratepolicytypes "github.com/outbe/outbe-node/x/ratepolicy/types"
+	throttletypes "github.com/outbe/outbe-node/x/throttle/types"
+	tokenhookstypes "github.com/outbe/outbe-node/x/tokenhooks/types"
+	txfeestypes "github.com/outbe/outbe-node/x/txfees/types"
+)
+
+// UpgradeName adds the modules introduced since the genesis binary.
+const UpgradeName = "v2"
+
+// NewUpgrade constructor
+func NewUpgrade() upgrades.Upgrade {
+	return upgrades.Upgrade{
+		UpgradeName:          UpgradeName,
+		CreateUpgradeHandler: CreateUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				msgfiltertypes.StoreKey,
+				tokenfactorytypes.StoreKey,
+				tokenhookstypes.StoreKey,
+				poatypes.StoreKey,
+				globalfeetypes.StoreKey,
+				txfeestypes.StoreKey,
+				throttletypes.StoreKey,
+				icaauthtypes.StoreKey,
+				icahostpolicytypes.StoreKey,
+				ratepolicytypes.StoreKey,
+				forwardingtypes.StoreKey,
+				contractquerytypes.StoreKey,
+				ibchookstypes.StoreKey,
+			},
+			Deleted: []string{},
+		},
+		PostUpgradeCheck: PostUpgradeCheck,
+	}
+}
+
+// PostUpgradeCheck requires the tokenfactory to charge the bond denom for new
+// denoms.
+func PostUpgradeCheck(ctx context.Context, ak *upgrades.AppKeepers) error {
+	bondDenom, err := ak.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range ak.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee {
+		if coin.Denom != bondDenom {
+			return fmt.Errorf("denom creation fee denom %s does not match the bond denom %s", coin.Denom, bondDenom)
+		}
+	}
+
+	return nil
+}
+
+// CreateUpgradeHandler initializes the added modules. The module manager runs
+// their InitGenesis with the default genesis as they are missing from fromVM;
+// the tokenfactory default then charges the SDK bond denom, which is replaced
+// with the chain's one like in the chain's default genesis.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		bondDenom, err := ak.StakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		params := ak.TokenFactoryKeeper.GetParams(ctx)
+		// charge 10 DisplayDenom per created denom
+		params.DenomCreationFee = sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.DefaultPowerReduction.MulRaw(10)))
+		if err := ak.TokenFactoryKeeper.SetParams(ctx, params); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
@@ -0,0 +1,368 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v8/types"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/app/upgrades"
+	"github.com/outbe/outbe-node/app/upgrades/noop"
+	"github.com/outbe/outbe-node/app/upgrades/pos"
+	v2 "github.com/outbe/outbe-node/app/upgrades/v2"
+	contractquerytypes "github.com/outbe/outbe-node/x/contractquery/types"
+	forwardingtypes "github.com/outbe/outbe-node/x/forwarding/types"
+	globalfeetypes "github.com/outbe/outbe-node/x/globalfee/types"
+	icaauthtypes "github.com/outbe/outbe-node/x/icaauth/types"
+	icahostpolicytypes "github.com/outbe/outbe-node/x/icahostpolicy/types"
+	msgfiltertypes "github.com/outbe/outbe-node/x/msgfilter/types"
+	poatypes "github.com/outbe/outbe-node/x/poa/types"
+	ratepolicytypes "github.com/outbe/outbe-node/x/ratepolicy/types"
+	throttletypes "github.com/outbe/outbe-node/x/throttle/types"
+	tokenhookstypes "github.com/outbe/outbe-node/x/tokenhooks/types"
+	txfeestypes "github.com/outbe/outbe-node/x/txfees/types"
+)
+
+// upgradeTests holds per upgrade the stores missing from the previous binary,
+// setup of the previous version's state and extra assertions on the migrated
+// state. Upgrades without an entry are still run against the default genesis
+// state of the current store set.
+var upgradeTests = map[string]struct {
+	unmounted []string
+	prepare   func(t *testing.T, ctx sdk.Context, app *ChainApp)
+	verify    func(t *testing.T, ctx sdk.Context, app *ChainApp)
+}{
+	v2.UpgradeName: {
+		unmounted: []string{
+			msgfiltertypes.StoreKey,
+			tokenfactorytypes.StoreKey,
+			tokenhookstypes.StoreKey,
+			poatypes.StoreKey,
+			globalfeetypes.StoreKey,
+			txfeestypes.StoreKey,
+			throttletypes.StoreKey,
+			icaauthtypes.StoreKey,
+			icahostpolicytypes.StoreKey,
+			ratepolicytypes.StoreKey,
+			forwardingtypes.StoreKey,
+			contractquerytypes.StoreKey,
+			ibchookstypes.StoreKey,
+		},
+		verify: func(t *testing.T, ctx sdk.Context, app *ChainApp) {
+			fee := app.TokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin(BaseDenom, sdk.DefaultPowerReduction.MulRaw(10))), fee)
+			require.False(t, app.PoAKeeper.GetParams(ctx).Enabled)
+			require.NoError(t, app.MsgFilterKeeper.GetParams(ctx).Validate())
+		},
+	},
+	pos.UpgradeName: {
+		prepare: func(t *testing.T, ctx sdk.Context, app *ChainApp) {
+			gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			require.NoError(t, app.PoAKeeper.SetParams(ctx, poatypes.NewParams(true, gov)))
+		},
+		verify: func(t *testing.T, ctx sdk.Context, app *ChainApp) {
+			require.False(t, app.PoAKeeper.GetParams(ctx).Enabled)
+			require.False(t, app.PoAKeeper.IsBlocked(ctx, sdk.MsgTypeURL(&poatypes.MsgAddValidator{}), nil))
+		},
+	},
+}
+
+func TestUpgrades(t *testing.T) {
+	for _, upgrade := range Upgrades {
+		t.Run(upgrade.UpgradeName, func(t *testing.T) {
+			tc := upgradeTests[upgrade.UpgradeName]
+
+			gapp, height, err := runUpgrade(t, upgrade, tc.unmounted, tc.prepare)
+			require.NoError(t, err)
+
+			ctx := gapp.uncachedContext()
+			done, err := gapp.UpgradeKeeper.GetDoneHeight(ctx, upgrade.UpgradeName)
+			require.NoError(t, err)
+			require.Equal(t, height, done)
+			requireUpgradeInvariants(t, ctx, gapp)
+
+			if tc.verify != nil {
+				tc.verify(t, ctx, gapp)
+			}
+		})
+	}
+}
+
+func TestUpgradeChecks(t *testing.T) {
+	errCheck := errors.New("check failed")
+	failing := func(context.Context, *upgrades.AppKeepers) error { return errCheck }
+
+	for _, tc := range []struct {
+		name    string
+		upgrade upgrades.Upgrade
+	}{
+		{"pre-upgrade", upgrades.Upgrade{UpgradeName: "pre", CreateUpgradeHandler: noop.CreateUpgradeHandler, PreUpgradeCheck: failing}},
+		{"post-upgrade", upgrades.Upgrade{UpgradeName: "post", CreateUpgradeHandler: noop.CreateUpgradeHandler, PostUpgradeCheck: failing}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := runUpgrade(t, tc.upgrade, nil, nil)
+			require.ErrorIs(t, err, errCheck)
+		})
+	}
+}
+
+func TestValidateUpgrades(t *testing.T) {
+	gapp := Setup(t)
+	registered := Upgrades
+	t.Cleanup(func() { Upgrades = registered })
+
+	for _, tc := range []struct {
+		name   string
+		stores storetypes.StoreUpgrades
+		valid  bool
+	}{
+		{"no stores", storetypes.StoreUpgrades{}, true},
+		{"add mounted store", storetypes.StoreUpgrades{Added: []string{poatypes.StoreKey}}, true},
+		{"add unmounted store", storetypes.StoreUpgrades{Added: []string{"unknown"}}, false},
+		{"delete mounted store", storetypes.StoreUpgrades{Deleted: []string{poatypes.StoreKey}}, false},
+		{"delete unmounted store", storetypes.StoreUpgrades{Deleted: []string{"legacy"}}, true},
+		{"rename to mounted store", storetypes.StoreUpgrades{Renamed: []storetypes.StoreRename{{OldKey: "legacy", NewKey: poatypes.StoreKey}}}, true},
+		{"rename to unmounted store", storetypes.StoreUpgrades{Renamed: []storetypes.StoreRename{{OldKey: "legacy", NewKey: "unknown"}}}, false},
+		{"store changed twice", storetypes.StoreUpgrades{Added: []string{poatypes.StoreKey}, Deleted: []string{poatypes.StoreKey}}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			Upgrades = []upgrades.Upgrade{{UpgradeName: "test", CreateUpgradeHandler: noop.CreateUpgradeHandler, StoreUpgrades: tc.stores}}
+			err := gapp.validateUpgrades()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	Upgrades = []upgrades.Upgrade{noop.NewUpgrade("v1"), noop.NewUpgrade("v1")}
+	require.Error(t, gapp.validateUpgrades())
+}
+
+// runUpgrade boots the previous binary, i.e. the app without the upgrade and
+// without the unmounted stores and their modules, lets prepare set up the previous version's state and schedules the upgrade. Once
+// the previous binary halts at the upgrade height, the current binary is
+// started on the same database and finalizes the upgrade block. It returns
+// the upgraded app and the upgrade height.
+func runUpgrade(
+	t *testing.T,
+	upgrade upgrades.Upgrade,
+	unmounted []string,
+	prepare func(t *testing.T, ctx sdk.Context, app *ChainApp),
+) (*ChainApp, int64, error) {
+	t.Helper()
+
+	registered := Upgrades
+	t.Cleanup(func() { Upgrades = registered })
+
+	previous := make([]upgrades.Upgrade, 0, len(registered))
+	for _, u := range registered {
+		if u.UpgradeName != upgrade.UpgradeName {
+			previous = append(previous, u)
+		}
+	}
+
+	db := dbm.NewMemDB()
+	oldHome, newHome := t.TempDir(), t.TempDir()
+
+	Upgrades = previous
+	oldApp := newPreviousApp(t, db, oldHome, unmounted)
+
+	_, err := oldApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: oldApp.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = oldApp.Commit()
+	require.NoError(t, err)
+
+	if prepare != nil {
+		prepare(t, oldApp.uncachedContext(), oldApp)
+	}
+
+	height := oldApp.LastBlockHeight() + 2
+	plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: height}
+	require.NoError(t, oldApp.UpgradeKeeper.ScheduleUpgrade(oldApp.uncachedContext(), plan))
+
+	for oldApp.LastBlockHeight() < height-1 {
+		_, err = oldApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: oldApp.LastBlockHeight() + 1})
+		require.NoError(t, err)
+		_, err = oldApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the previous binary halts at the upgrade height and writes the plan to
+	// disk for the store loader of the next binary
+	_, err = oldApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+	require.ErrorContains(t, err, "NEEDED")
+	copyUpgradeInfo(t, oldHome, newHome)
+
+	Upgrades = append(previous, upgrade)
+	newApp := NewChainApp(log.NewNopLogger(), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(newHome), nil)
+
+	if _, err := newApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height}); err != nil {
+		return nil, 0, err
+	}
+	_, err = newApp.Commit()
+	require.NoError(t, err)
+
+	return newApp, height, nil
+}
+
+// storeModules maps the store keys not named after their module to it.
+var storeModules = map[string]string{
+	icahostpolicytypes.StoreKey: icahostpolicytypes.ModuleName,
+	ibchookstypes.StoreKey:      ibchookstypes.ModuleName,
+}
+
+// newPreviousApp returns an app at genesis without the unmounted stores nor
+// their modules, like the binary an upgrade starts from.
+func newPreviousApp(t *testing.T, db dbm.DB, home string, unmounted []string) *ChainApp {
+	t.Helper()
+
+	skipped := make(map[string]bool, len(unmounted))
+	for _, key := range unmounted {
+		skipped[key] = true
+	}
+	withoutStores := func(bapp *baseapp.BaseApp) {
+		bapp.SetCMS(unmountedStores{
+			CommitMultiStore: store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics()),
+			skipped:          skipped,
+		})
+	}
+	app := NewChainApp(log.NewNopLogger(), db, nil, true, simtestutil.NewAppOptionsWithFlagHome(home), nil, withoutStores)
+
+	removed := func(name string) bool {
+		if skipped[name] {
+			return true
+		}
+		for key, module := range storeModules {
+			if module == name && skipped[key] {
+				return true
+			}
+		}
+		return false
+	}
+	mm := app.ModuleManager
+	maps.DeleteFunc(mm.Modules, func(name string, _ any) bool { return removed(name) })
+	for _, order := range []*[]string{
+		&mm.OrderInitGenesis, &mm.OrderExportGenesis, &mm.OrderPreBlockers, &mm.OrderBeginBlockers,
+		&mm.OrderEndBlockers, &mm.OrderPrepareCheckStaters, &mm.OrderPrecommiters, &mm.OrderMigrations,
+	} {
+		*order = slices.DeleteFunc(*order, removed)
+	}
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	acc := authtypes.NewBaseAccount(secp256k1.GenPrivKey().PubKey().Address().Bytes(), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+	genesisState, err := GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	return app
+}
+
+// unmountedStores leaves the skipped stores unmounted.
+type unmountedStores struct {
+	storetypes.CommitMultiStore
+	skipped map[string]bool
+}
+
+func (s unmountedStores) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	if !s.skipped[key.Name()] {
+		s.CommitMultiStore.MountStoreWithDB(key, typ, db)
+	}
+}
+
+func copyUpgradeInfo(t *testing.T, fromHome, toHome string) {
+	t.Helper()
+
+	bz, err := os.ReadFile(filepath.Join(fromHome, "data", upgradetypes.UpgradeInfoFilename))
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(toHome, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(toHome, "data", upgradetypes.UpgradeInfoFilename), bz, 0o600))
+}
+
+// uncachedContext returns a context writing directly to the committed
+// multistore, so that changes are part of the next block.
+func (app *ChainApp) uncachedContext() sdk.Context {
+	return app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+}
+
+// requireUpgradeInvariants checks the state every upgrade must leave behind:
+// all module versions migrated and all registered invariants holding.
+func requireUpgradeInvariants(t *testing.T, ctx sdk.Context, app *ChainApp) {
+	t.Helper()
+
+	vm, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), vm)
+
+	require.NotPanics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
+}
+
+func TestDryRunUpgrade(t *testing.T) {
+	gapp := Setup(t)
+	_, err := gapp.Commit()
+	require.NoError(t, err)
+
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.NoError(t, gapp.PoAKeeper.SetParams(gapp.uncachedContext(), poatypes.NewParams(true, gov)))
+
+	_, err = gapp.DryRunUpgrade("unknown")
+	require.Error(t, err)
+
+	res, err := gapp.DryRunUpgrade(pos.UpgradeName)
+	require.NoError(t, err)
+	require.Equal(t, gapp.LastBlockHeight()+1, res.Height)
+	require.Equal(t, gapp.ModuleManager.GetVersionMap(), res.ToVersions)
+	require.NotZero(t, res.GasConsumed)
+
+	// nothing was written
+	ctx := gapp.uncachedContext()
+	require.True(t, gapp.PoAKeeper.GetParams(ctx).Enabled)
+	done, err := gapp.UpgradeKeeper.GetDoneHeight(ctx, pos.UpgradeName)
+	require.NoError(t, err)
+	require.Zero(t, done)
+}
@@ -1,5 +1,11 @@
 package app
 
+import (
+	"fmt"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+)
+
 // AllCapabilities returns all capabilities available with the current wasmvm
 // See https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 // This functionality is going to be moved upstream: https://github.com/CosmWasm/wasmvm/issues/425
@@ -17,3 +23,65 @@ func AllCapabilities() []string {
 		"cosmwasm_2_2",
 	}
 }
+
+// DefaultCapabilities returns the capabilities of the wasm VMs when app.toml
+// does not set any.
+func DefaultCapabilities() []string {
+	return AllCapabilities()
+}
+
+// capabilitySince is the first libwasmvm major and minor version supporting
+// each of AllCapabilities.
+var capabilitySince = map[string][2]int{
+	"iterator":     {1, 0},
+	"staking":      {1, 0},
+	"stargate":     {1, 0},
+	"cosmwasm_1_1": {1, 1},
+	"cosmwasm_1_2": {1, 2},
+	"cosmwasm_1_3": {1, 3},
+	"cosmwasm_1_4": {1, 4},
+	"cosmwasm_2_0": {2, 0},
+	"cosmwasm_2_1": {2, 1},
+	"cosmwasm_2_2": {2, 2},
+}
+
+// ValidateCapabilities checks that the linked libwasmvm supports every
+// capability.
+func ValidateCapabilities(capabilities []string) error {
+	version, err := wasmvm.LibwasmvmVersion()
+	if err != nil {
+		return fmt.Errorf("libwasmvm version: %w", err)
+	}
+
+	return validateCapabilities(capabilities, version)
+}
+
+func validateCapabilities(capabilities []string, libwasmvmVersion string) error {
+	var major, minor int
+	if _, err := fmt.Sscanf(libwasmvmVersion, "%d.%d", &major, &minor); err != nil {
+		return fmt.Errorf("invalid libwasmvm version %q: %w", libwasmvmVersion, err)
+	}
+
+	if len(capabilities) == 0 {
+		return fmt.Errorf("no wasm capabilities")
+	}
+
+	seen := make(map[string]bool, len(capabilities))
+	for _, c := range capabilities {
+		if seen[c] {
+			return fmt.Errorf("duplicate wasm capability %q", c)
+		}
+		seen[c] = true
+
+		since, ok := capabilitySince[c]
+		if !ok {
+			return fmt.Errorf("unknown wasm capability %q, known: %v", c, AllCapabilities())
+		}
+
+		if major < since[0] || (major == since[0] && minor < since[1]) {
+			return fmt.Errorf("wasm capability %q needs libwasmvm %d.%d, linked %s", c, since[0], since[1], libwasmvmVersion)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	wasmlctypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// unpinnedLightClientChecksumsFile is the file in the 08-wasm VM dir listing
+// the stored light client checksums the node does not pin at startup. The pin
+// cache of the VM lives in memory, so the choice is kept next to the compiled
+// contracts rather than in the chain state.
+const unpinnedLightClientChecksumsFile = "unpinned-checksums.json"
+
+// ParseLightClientChecksum decodes a hex encoded light client checksum.
+func ParseLightClientChecksum(s string) (wasmlctypes.Checksum, error) {
+	checksum, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checksum %q: %w", s, err)
+	}
+
+	if err := wasmlctypes.ValidateWasmChecksum(checksum); err != nil {
+		return nil, fmt.Errorf("invalid checksum %q: %w", s, err)
+	}
+
+	return checksum, nil
+}
+
+// ReadUnpinnedLightClientChecksums returns the hex encoded checksums unpinned
+// in the VM dir, sorted. All checksums are pinned if the dir has none.
+func ReadUnpinnedLightClientChecksums(vmDir string) ([]string, error) {
+	bz, err := os.ReadFile(filepath.Join(vmDir, unpinnedLightClientChecksumsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checksums []string
+	if err := json.Unmarshal(bz, &checksums); err != nil {
+		return nil, fmt.Errorf("%s: %w", unpinnedLightClientChecksumsFile, err)
+	}
+
+	for _, c := range checksums {
+		if _, err := ParseLightClientChecksum(c); err != nil {
+			return nil, fmt.Errorf("%s: %w", unpinnedLightClientChecksumsFile, err)
+		}
+	}
+
+	slices.Sort(checksums)
+	return checksums, nil
+}
+
+// SetLightClientChecksumPinned records in the VM dir whether the node pins the
+// light client contract of the hex encoded checksum at startup. It reports
+// whether the record changed.
+func SetLightClientChecksumPinned(vmDir, checksum string, pinned bool) (bool, error) {
+	if _, err := ParseLightClientChecksum(checksum); err != nil {
+		return false, err
+	}
+	checksum = strings.ToLower(checksum)
+
+	unpinned, err := ReadUnpinnedLightClientChecksums(vmDir)
+	if err != nil {
+		return false, err
+	}
+
+	i, found := slices.BinarySearch(unpinned, checksum)
+	switch {
+	case pinned && found:
+		unpinned = slices.Delete(unpinned, i, i+1)
+	case !pinned && !found:
+		unpinned = slices.Insert(unpinned, i, checksum)
+	default:
+		return false, nil
+	}
+
+	if err := os.MkdirAll(vmDir, 0o755); err != nil {
+		return false, err
+	}
+
+	bz, err := json.MarshalIndent(unpinned, "", "  ")
+	if err != nil {
+		return false, err
+	}
+
+	return true, os.WriteFile(filepath.Join(vmDir, unpinnedLightClientChecksumsFile), bz, 0o600)
+}
+
+// lightClientPinner is the part of the 08-wasm VM pinning contracts in memory.
+type lightClientPinner interface {
+	Pin(checksum wasmlctypes.Checksum) error
+}
+
+// pinLightClientCodes pins the stored light client contracts of checksums in
+// the VM, except the unpinned ones.
+func pinLightClientCodes(vm lightClientPinner, checksums []wasmlctypes.Checksum, unpinned []string) error {
+	for _, checksum := range checksums {
+		if slices.Contains(unpinned, hex.EncodeToString(checksum)) {
+			continue
+		}
+
+		if err := vm.Pin(checksum); err != nil {
+			return fmt.Errorf("pin %x: %w", checksum, err)
+		}
+	}
+
+	return nil
+}
+
+// initializeLightClientPinnedCodes pins the stored light client contracts in
+// the VM cache, except the ones unpinned in the VM dir.
+func (app *ChainApp) initializeLightClientPinnedCodes(ctx sdk.Context) error {
+	checksums, err := wasmlctypes.GetAllChecksums(ctx)
+	if err != nil {
+		return err
+	}
+
+	unpinned, err := ReadUnpinnedLightClientChecksums(app.wasmLightClientDir)
+	if err != nil {
+		return err
+	}
+
+	return pinLightClientCodes(app.wasmLightClientVM, checksums, unpinned)
+}
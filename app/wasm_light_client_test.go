@@ -0,0 +1,116 @@
+package app
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmlctypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestReadWasmLightClientConfig(t *testing.T) {
+	cfg, err := ReadWasmLightClientConfig(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultWasmLightClientConfig(), cfg)
+	require.Equal(t, filepath.Join("/home", "data", "08-light-client"), cfg.VMDir("/home"))
+
+	cfg, err = ReadWasmLightClientConfig(simtestutil.AppOptionsMap{
+		FlagWasmLightClientDir:                 "/var/lib/lc",
+		FlagWasmLightClientMemoryCacheSize:     int64(256),
+		FlagWasmLightClientInstanceMemoryLimit: int64(64),
+		FlagWasmLightClientDebug:               true,
+		FlagWasmLightClientQueryAllowList:      []any{"/ibc.core.client.v1.Query/ClientState"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, WasmLightClientConfig{
+		Dir:                 "/var/lib/lc",
+		MemoryCacheSize:     256,
+		InstanceMemoryLimit: 64,
+		Debug:               true,
+		QueryAllowList:      []string{"/ibc.core.client.v1.Query/ClientState"},
+	}, cfg)
+	require.Equal(t, "/var/lib/lc", cfg.VMDir("/home"))
+
+	cfg, err = ReadWasmLightClientConfig(simtestutil.AppOptionsMap{FlagWasmLightClientQueryAllowList: []any{}})
+	require.NoError(t, err)
+	require.Equal(t, DefaultWasmLightClientQueryAllowList(), cfg.QueryAllowList)
+
+	for name, tc := range map[string]struct {
+		opts simtestutil.AppOptionsMap
+		flag string
+	}{
+		"empty dir":         {opts: simtestutil.AppOptionsMap{FlagWasmLightClientDir: ""}, flag: FlagWasmLightClientDir},
+		"zero cache":        {opts: simtestutil.AppOptionsMap{FlagWasmLightClientMemoryCacheSize: 0}, flag: FlagWasmLightClientMemoryCacheSize},
+		"zero memory limit": {opts: simtestutil.AppOptionsMap{FlagWasmLightClientInstanceMemoryLimit: 0}, flag: FlagWasmLightClientInstanceMemoryLimit},
+		"invalid debug":     {opts: simtestutil.AppOptionsMap{FlagWasmLightClientDebug: "maybe"}, flag: FlagWasmLightClientDebug},
+		"invalid path":      {opts: simtestutil.AppOptionsMap{FlagWasmLightClientQueryAllowList: []any{"ibc.core.client.v1.Query/ClientState"}}, flag: FlagWasmLightClientQueryAllowList},
+		"duplicate path": {
+			opts: simtestutil.AppOptionsMap{FlagWasmLightClientQueryAllowList: []any{"/a.Query/B", "/a.Query/B"}},
+			flag: FlagWasmLightClientQueryAllowList,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadWasmLightClientConfig(tc.opts)
+			require.ErrorContains(t, err, tc.flag)
+		})
+	}
+}
+
+type pinRecorder struct {
+	pinned []string
+}
+
+func (r *pinRecorder) Pin(checksum wasmlctypes.Checksum) error {
+	r.pinned = append(r.pinned, hex.EncodeToString(checksum))
+	return nil
+}
+
+func TestLightClientChecksumPinning(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "08-light-client")
+	a, b := strings.Repeat("aa", 32), strings.Repeat("bb", 32)
+
+	unpinned, err := ReadUnpinnedLightClientChecksums(dir)
+	require.NoError(t, err)
+	require.Empty(t, unpinned)
+
+	_, err = SetLightClientChecksumPinned(dir, "abcd", false)
+	require.Error(t, err)
+	_, err = SetLightClientChecksumPinned(dir, strings.Repeat("zz", 32), false)
+	require.Error(t, err)
+
+	changed, err := SetLightClientChecksumPinned(dir, strings.ToUpper(b), false)
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = SetLightClientChecksumPinned(dir, a, false)
+	require.NoError(t, err)
+	require.True(t, changed)
+	changed, err = SetLightClientChecksumPinned(dir, a, false)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	unpinned, err = ReadUnpinnedLightClientChecksums(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{a, b}, unpinned)
+
+	changed, err = SetLightClientChecksumPinned(dir, a, true)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	unpinned, err = ReadUnpinnedLightClientChecksums(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{b}, unpinned)
+
+	checksumA, err := ParseLightClientChecksum(a)
+	require.NoError(t, err)
+	checksumB, err := ParseLightClientChecksum(b)
+	require.NoError(t, err)
+
+	vm := &pinRecorder{}
+	require.NoError(t, pinLightClientCodes(vm, []wasmlctypes.Checksum{checksumA, checksumB}, unpinned))
+	require.Equal(t, []string{a}, vm.pinned)
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestValidateCapabilities(t *testing.T) {
+	require.NoError(t, validateCapabilities(AllCapabilities(), "2.2.3"))
+	require.NoError(t, validateCapabilities([]string{"iterator", "cosmwasm_1_4"}, "1.5.0"))
+
+	for name, tc := range map[string]struct {
+		capabilities []string
+		version      string
+	}{
+		"empty":           {capabilities: []string{}, version: "2.2.3"},
+		"unknown":         {capabilities: []string{"iterator", "neutron"}, version: "2.2.3"},
+		"duplicate":       {capabilities: []string{"staking", "staking"}, version: "2.2.3"},
+		"too new":         {capabilities: []string{"cosmwasm_2_2"}, version: "2.1.4"},
+		"invalid version": {capabilities: []string{"iterator"}, version: "dev"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, validateCapabilities(tc.capabilities, tc.version))
+		})
+	}
+}
+
+func TestReadWasmVMConfig(t *testing.T) {
+	cfg, err := ReadWasmVMConfig(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultCapabilities(), cfg.Capabilities)
+
+	cfg, err = ReadWasmVMConfig(simtestutil.AppOptionsMap{FlagWasmCapabilities: []any{}})
+	require.NoError(t, err)
+	require.Equal(t, DefaultCapabilities(), cfg.Capabilities)
+
+	cfg, err = ReadWasmVMConfig(simtestutil.AppOptionsMap{FlagWasmCapabilities: []any{"iterator", "cosmwasm_2_0"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"iterator", "cosmwasm_2_0"}, cfg.Capabilities)
+
+	_, err = ReadWasmVMConfig(simtestutil.AppOptionsMap{FlagWasmCapabilities: []any{"cosmwasm_9_9"}})
+	require.ErrorContains(t, err, FlagWasmCapabilities)
+}
@@ -8,10 +8,10 @@ import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/outbe/outbe-node/app"
+	forwardingtypes "github.com/outbe/outbe-node/x/forwarding/types"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
@@ -52,9 +52,13 @@ func initCometBFTConfig() *cmtcfg.Config {
 }
 
 type CustomAppConfig struct {
-	serverconfig.Config
+	serverconfig.Config `mapstructure:",squash"`
 
-	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+	Wasm            wasmtypes.WasmConfig       `mapstructure:"wasm"`
+	Ante            app.AnteConfig             `mapstructure:"ante"`
+	PacketForward   forwardingtypes.NodeConfig `mapstructure:"packet-forward"`
+	WasmVM          app.WasmVMConfig           `mapstructure:"wasm-vm"`
+	WasmLightClient app.WasmLightClientConfig  `mapstructure:"wasm-light-client"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -76,18 +80,27 @@ func initAppConfig() (string, interface{}) {
 	// - if you set srvCfg.MinGasPrices non-empty, validators CAN tweak their
 	//   own app.toml to override, or use this default value.
 	//
-	// In simapp, we set the min gas prices to 0.
-	srvCfg.MinGasPrices = "0stake"
+	// The local price defaults to zero in the chain denom; the chain wide
+	// minimum is set by governance in x/globalfee.
+	srvCfg.MinGasPrices = "0" + app.BaseDenom
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultWasmConfig(),
+		Config:          *srvCfg,
+		Wasm:            wasmtypes.DefaultWasmConfig(),
+		Ante:            app.DefaultAnteConfig(),
+		PacketForward:   forwardingtypes.DefaultNodeConfig(),
+		WasmVM:          app.DefaultWasmVMConfig(),
+		WasmLightClient: app.DefaultWasmLightClientConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate
 
 	customAppTemplate += wasmtypes.DefaultConfigTemplate()
+	customAppTemplate += app.AnteConfigTemplate()
+	customAppTemplate += app.PacketForwardConfigTemplate()
+	customAppTemplate += app.WasmVMConfigTemplate()
+	customAppTemplate += app.WasmLightClientConfigTemplate()
 
 	return customAppTemplate, customAppConfig
 }
@@ -103,19 +116,22 @@ func initRootCmd(
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(chainApp.BasicModuleManager, app.DefaultNodeHome),
 		cmtcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCommand(chainApp),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		upgradeCommand(),
+		lightClientCommand(),
 	)
 
 	sdkserver.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)
 	wasmcli.ExtendUnsafeResetAllCmd(rootCmd)
+	extendExportCmd(rootCmd)
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(chainApp.TxConfig(), chainApp.BasicModuleManager),
+		genesisCommand(chainApp.TxConfig(), chainApp.BasicModuleManager, genesisBuildCommand(chainApp)),
 		keys.Commands(),
 		queryCommand(),
 		txCommand(),
@@ -143,6 +159,13 @@ func initRootCmd(
 	// )
 }
 
+// debugCommand adds the chain state tooling to the debug commands of the SDK.
+func debugCommand(chainApp *app.ChainApp) *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(stateDiffCommand(chainApp))
+	return cmd
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	wasm.AddModuleInitFlags(startCmd)
@@ -152,6 +175,13 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 func genesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, cmds ...*cobra.Command) *cobra.Command {
 	cmd := genutilcli.Commands(txConfig, basicManager, app.DefaultNodeHome)
 
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.Name() == "validate" {
+			cmd.RemoveCommand(subCmd)
+		}
+	}
+	cmd.AddCommand(validateGenesisCommand(basicManager))
+
 	for _, subCmd := range cmds {
 		cmd.AddCommand(subCmd)
 	}
@@ -241,24 +271,52 @@ func appExport(
 	appOpts servertypes.AppOptions,
 	modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
-	var chainApp *app.ChainApp
+	chainApp, cleanup, err := newExportApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	defer cleanup()
+
+	exported, report, err := chainApp.ExportAppStateAndValidatorsWithReport(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	if err != nil {
+		return exported, err
+	}
+
+	if reportFile := cast.ToString(appOpts.Get(flagZeroHeightReport)); forZeroHeight && reportFile != "" {
+		if err := writeZeroHeightReport(reportFile, report); err != nil {
+			return exported, err
+		}
+	}
+
+	return exported, nil
+}
+
+// newExportApp creates a new wasm app loaded at the given height, -1 for the
+// latest, to export its state. The app runs on a temp home so it leaves the
+// wasm dirs of the node alone; the returned func removes it.
+func newExportApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*app.ChainApp, func(), error) {
 	// this check is necessary as we use the flag in x/upgrade.
 	// we can exit more gracefully by checking the flag here.
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home is not set")
+		return nil, nil, errors.New("application home is not set")
 	}
 
-	viperAppOpts, ok := appOpts.(*viper.Viper)
-	if !ok {
-		return servertypes.ExportedApp{}, errors.New("appOpts is not viper.Viper")
+	appOpts, cleanup, err := tempHomeAppOptions(overrideAppOptions{
+		AppOptions: appOpts,
+		values:     map[string]any{server.FlagInvCheckPeriod: 1},
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// overwrite the FlagInvCheckPeriod
-	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
-	appOpts = viperAppOpts
-
-	chainApp = app.NewChainApp(
+	chainApp := app.NewChainApp(
 		logger,
 		db,
 		traceStore,
@@ -269,11 +327,12 @@ func appExport(
 
 	if height != -1 {
 		if err := chainApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			cleanup()
+			return nil, nil, err
 		}
 	}
 
-	return chainApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	return chainApp, cleanup, nil
 }
 
 var tempDir = func() string {
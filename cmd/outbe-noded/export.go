@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+const (
+	flagStreaming        = "streaming"
+	flagOutDir           = "out-dir"
+	flagDryRun           = "dry-run"
+	flagZeroHeightReport = "zero-height-report"
+)
+
+// extendExportCmd adds a streaming mode to the export command of the server,
+// writing the genesis state of each module to its own file as it is exported
+// instead of building the whole app state in memory, and a dry-run of the
+// state changes of a zero height export.
+func extendExportCmd(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() != "export" {
+			continue
+		}
+
+		serverRunE := cmd.RunE
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+				return zeroHeightDryRun(cmd)
+			}
+			if streaming, _ := cmd.Flags().GetBool(flagStreaming); !streaming {
+				return serverRunE(cmd, args)
+			}
+			return streamingExport(cmd)
+		}
+		cmd.Long = `Export state to JSON.
+
+With --streaming, the genesis state of each module is written to its own file
+in <out-dir>/app_state as soon as it is exported, and <out-dir>/genesis.json
+lists the files instead of holding the app state. To start a chain from it,
+copy both into the config dir of the node home. The node checks every module
+file against the hash in genesis.json before it inits the module.
+
+With --for-zero-height, the state is first prepared for a chain starting at
+height zero: rewards and commissions are withdrawn, distribution and staking
+heights are reset and validators missing from --jail-allowed-addrs are jailed.
+--zero-height-report writes every such change to a JSON file, and --dry-run
+prints them without exporting, leaving the database untouched.`
+		cmd.Example = fmt.Sprintf("%s export --streaming --out-dir ./export --home ~/.outbe-node", version.AppName)
+		cmd.Flags().Bool(flagStreaming, false, "Write the genesis state of each module to its own file in --out-dir")
+		cmd.Flags().String(flagOutDir, "", "The empty or missing dir a streaming export is written to")
+		cmd.Flags().Bool(flagDryRun, false, "Print the state changes of a zero height export without exporting")
+		cmd.Flags().String(flagZeroHeightReport, "", "The file the state changes of a zero height export are written to")
+
+		return
+	}
+}
+
+func streamingExport(cmd *cobra.Command) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	outDir, _ := cmd.Flags().GetString(flagOutDir)
+	if outDir == "" {
+		return fmt.Errorf("--%s is required with --%s", flagOutDir, flagStreaming)
+	}
+	if outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument); outputDocument != "" {
+		return fmt.Errorf("--%s cannot be used with --%s", flags.FlagOutputDocument, flagStreaming)
+	}
+	if entries, err := os.ReadDir(outDir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("%s is not empty", outDir)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if _, err := os.Stat(config.GenesisFile()); err != nil {
+		return err
+	}
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+	modulesToExport, _ := cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+
+	chainApp, cleanup, err := newExportApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+	if err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+	defer cleanup()
+
+	exported, report, err := chainApp.ExportAppStateAndValidatorsToDir(forZeroHeight, jailAllowedAddrs, modulesToExport, outDir)
+	if err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+
+	if reportFile, _ := cmd.Flags().GetString(flagZeroHeightReport); forZeroHeight && reportFile != "" {
+		if err := writeZeroHeightReport(reportFile, report); err != nil {
+			return err
+		}
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	// set current binary version
+	appGenesis.AppName = version.AppName
+	appGenesis.AppVersion = version.Version
+
+	appGenesis.AppState = exported.AppState
+	appGenesis.InitialHeight = exported.Height
+	appGenesis.Consensus = genutiltypes.NewConsensusGenesis(exported.ConsensusParams, exported.Validators)
+
+	genesisFile := filepath.Join(outDir, "genesis.json")
+	if err := appGenesis.SaveAs(genesisFile); err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("exported %s and %s\n", genesisFile, filepath.Join(outDir, app.StreamedAppStateDir))
+	return nil
+}
+
+func zeroHeightDryRun(cmd *cobra.Command) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	if forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight); !forZeroHeight {
+		return fmt.Errorf("--%s needs --%s", flagDryRun, server.FlagForZeroHeight)
+	}
+
+	db, err := openReadOnlyDB(config.DBDir(), server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return fmt.Errorf("failed to open application database: %w", err)
+	}
+	overlay := newOverlayDB(db)
+	defer overlay.Close()
+
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+
+	chainApp, cleanup, err := newExportApp(serverCtx.Logger, overlay, nil, height, serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	report, err := chainApp.DryRunZeroHeightExport(jailAllowedAddrs)
+	if err != nil {
+		return fmt.Errorf("zero height export would fail: %w", err)
+	}
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(bz))
+	return nil
+}
+
+func writeZeroHeightReport(path string, report app.ZeroHeightReport) error {
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o644)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+const (
+	// flagNetworkConfig is bound to the "config" viper key along with the
+	// other flags; the app config must keep squashing the server config so
+	// that the key is not decoded into it.
+	flagNetworkConfig = "config"
+	flagOutput        = "output"
+	flagOverwrite     = "overwrite"
+)
+
+func genesisBuildCommand(chainApp *app.ChainApp) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Build a full genesis file from a declarative network spec",
+		Long: `Build a genesis file from a YAML network spec and validate it with the genesis
+validation of every module.
+
+The spec declares the chain id, consensus params, accounts with their vesting
+schedules, the metadata of the native denom, params merged into the genesis
+params of any module, the dir of the gentxs to collect and the wasm codes to
+store. Everything else keeps the default genesis of the chain, with the
+native denom of the modules set to ` + app.BaseDenom + `. Paths in the spec are
+relative to its dir. See scripts/network.yaml for an example.
+
+The genesis is written to the genesis file of --home unless --output is set.`,
+		Example: fmt.Sprintf("%s genesis build --config network.yaml --home ~/.outbe-node --overwrite", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			specFile, _ := cmd.Flags().GetString(flagNetworkConfig)
+			output, _ := cmd.Flags().GetString(flagOutput)
+			overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+			if output == "" {
+				output = config.GenesisFile()
+			}
+
+			if _, err := os.Stat(output); err == nil && !overwrite {
+				return fmt.Errorf("%s already exists, use --%s to replace it", output, flagOverwrite)
+			}
+
+			spec, err := readNetworkSpec(specFile)
+			if err != nil {
+				return err
+			}
+
+			appGenesis, persistentPeers, err := chainApp.BuildGenesis(spec)
+			if err != nil {
+				return err
+			}
+
+			if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+				return err
+			}
+			if err := appGenesis.SaveAs(output); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("genesis of %s written to %s\n", appGenesis.ChainID, output)
+			if persistentPeers != "" {
+				cmd.PrintErrf("persistent peers: %s\n", persistentPeers)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNetworkConfig, "", "The YAML network spec to build the genesis from")
+	cmd.Flags().String(flagOutput, "", "The file the genesis is written to, the genesis file of --home if empty")
+	cmd.Flags().Bool(flagOverwrite, false, "Replace an existing genesis file")
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	_ = cmd.MarkFlagRequired(flagNetworkConfig)
+
+	return cmd
+}
+
+// readNetworkSpec reads a YAML network spec, rejecting unknown fields, and
+// makes its paths relative to the working dir.
+func readNetworkSpec(file string) (app.NetworkSpec, error) {
+	var spec app.NetworkSpec
+
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return spec, err
+	}
+	bz, err = yaml.YAMLToJSON(bz)
+	if err != nil {
+		return spec, fmt.Errorf("%s: %w", file, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return spec, fmt.Errorf("%s: %w", file, err)
+	}
+
+	dir := filepath.Dir(file)
+	if spec.GenTxsDir != "" && !filepath.IsAbs(spec.GenTxsDir) {
+		spec.GenTxsDir = filepath.Join(dir, spec.GenTxsDir)
+	}
+	for i, code := range spec.WasmCodes {
+		if !filepath.IsAbs(code.File) {
+			spec.WasmCodes[i].File = filepath.Join(dir, code.File)
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+func TestGenesisBuildExample(t *testing.T) {
+	spec, err := readNetworkSpec("../../scripts/network.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "localchain-1", spec.ChainID)
+	require.Len(t, spec.Accounts, 2)
+	require.Equal(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), spec.Accounts[1].Vesting.End)
+
+	chainApp := app.NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+	appGenesis, _, err := chainApp.BuildGenesis(spec)
+	require.NoError(t, err)
+	require.Equal(t, int64(100000000), appGenesis.Consensus.Params.Block.MaxGas)
+}
+
+func TestGenesisBuildCommand(t *testing.T) {
+	// the config flag must not be read as the app config of a fresh home
+	home := t.TempDir()
+	rootCmd := NewRootCmd()
+	rootCmd.SetArgs([]string{"genesis", "build", "--config", "../../scripts/network.yaml", "--home", home})
+	require.NoError(t, svrcmd.Execute(rootCmd, "", home))
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, "localchain-1", appGenesis.ChainID)
+}
+
+func TestReadNetworkSpec(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "network.yaml")
+
+	require.NoError(t, os.WriteFile(file, []byte(`chain_id: test-1
+gentxs_dir: gentxs
+wasm_codes:
+  - file: codes/a.wasm
+  - file: /codes/b.wasm
+`), 0o600))
+	spec, err := readNetworkSpec(file)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "gentxs"), spec.GenTxsDir)
+	require.Equal(t, filepath.Join(dir, "codes/a.wasm"), spec.WasmCodes[0].File)
+	require.Equal(t, "/codes/b.wasm", spec.WasmCodes[1].File)
+
+	require.NoError(t, os.WriteFile(file, []byte("chain_id: test-1\naccount:\n  - address: x\n"), 0o600))
+	_, err = readNetworkSpec(file)
+	require.ErrorContains(t, err, `unknown field "account"`)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+// validateGenesisCommand replaces the validate command of the SDK to also
+// validate a streamed app state, whose module files are read from the dir of
+// the genesis file, like a node reads them from its config dir.
+func validateGenesisCommand(basicManager module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:     "validate [file]",
+		Aliases: []string{"validate-genesis"},
+		Args:    cobra.RangeArgs(0, 1),
+		Short:   "Validates the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesis := serverCtx.Config.GenesisFile()
+			if len(args) > 0 {
+				genesis = args[0]
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesis)
+			if err != nil {
+				return err
+			}
+
+			if err := appGenesis.ValidateAndComplete(); err != nil {
+				return fmt.Errorf("invalid consensus genesis: %w", err)
+			}
+
+			var genState app.GenesisState
+			if err := json.Unmarshal(appGenesis.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %w", genesis, err)
+			}
+
+			if _, ok := genState[app.StreamedAppStateKey]; ok {
+				err = app.ValidateStreamedGenesis(basicManager, clientCtx.Codec, clientCtx.TxConfig, genState, filepath.Dir(genesis))
+			} else {
+				err = basicManager.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, genState)
+			}
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return fmt.Errorf("error validating genesis file %s: %w: section is missing in the app_state", genesis, err)
+				}
+				return fmt.Errorf("error validating genesis file %s: %w", genesis, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+}
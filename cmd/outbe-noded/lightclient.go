@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	wasmlctypes "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+// lightClientCommand groups the node local tooling of the 08-wasm light
+// client VM.
+func lightClientCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "wasm-light-client",
+		Short:                      "08-wasm light client VM subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		lightClientChecksumsCommand(),
+		lightClientPinCommand(true),
+		lightClientPinCommand(false),
+	)
+
+	return cmd
+}
+
+func lightClientChecksumsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checksums",
+		Short: "List the stored light client checksums and whether this node pins them",
+		Long: `List the checksums of the light client contracts stored on the chain, queried
+from --node, and whether the node of --home pins them in the VM cache.`,
+		Example: fmt.Sprintf("%s wasm-light-client checksums --home ~/.outbe-node", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			vmDir, err := lightClientVMDir(cmd)
+			if err != nil {
+				return err
+			}
+
+			unpinned, err := app.ReadUnpinnedLightClientChecksums(vmDir)
+			if err != nil {
+				return err
+			}
+
+			res, err := wasmlctypes.NewQueryClient(clientCtx).Checksums(cmd.Context(), &wasmlctypes.QueryChecksumsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			printLightClientChecksums(cmd.OutOrStdout(), res.Checksums, unpinned)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "checksums")
+
+	return cmd
+}
+
+func lightClientPinCommand(pin bool) *cobra.Command {
+	use, short := "pin", "Pin a light client contract in the VM cache of this node"
+	if !pin {
+		use, short = "unpin", "Stop pinning a light client contract in the VM cache of this node"
+	}
+
+	return &cobra.Command{
+		Use:   use + " [checksum]",
+		Short: short,
+		Long: short + `.
+
+The node pins every stored light client contract at startup unless it is
+unpinned. The choice is kept in the VM dir of --home and takes effect when the
+node restarts.`,
+		Example: fmt.Sprintf("%s wasm-light-client %s <hex checksum> --home ~/.outbe-node", version.AppName, use),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vmDir, err := lightClientVMDir(cmd)
+			if err != nil {
+				return err
+			}
+
+			changed, err := app.SetLightClientChecksumPinned(vmDir, args[0], pin)
+			if err != nil {
+				return err
+			}
+
+			state := "pinned"
+			if !pin {
+				state = "unpinned"
+			}
+
+			if !changed {
+				cmd.Printf("%s is already %s\n", strings.ToLower(args[0]), state)
+				return nil
+			}
+
+			cmd.Printf("%s is %s from the next node restart\n", strings.ToLower(args[0]), state)
+			return nil
+		},
+	}
+}
+
+// lightClientVMDir returns the 08-wasm VM dir of the node of the command home.
+func lightClientVMDir(cmd *cobra.Command) (string, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	cfg, err := app.ReadWasmLightClientConfig(serverCtx.Viper)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.VMDir(serverCtx.Config.RootDir), nil
+}
+
+func printLightClientChecksums(w io.Writer, checksums, unpinned []string) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	fmt.Fprintln(tw, "CHECKSUM\tPINNED")
+	for _, checksum := range checksums {
+		pinned := !slices.Contains(unpinned, strings.ToLower(checksum))
+		fmt.Fprintf(tw, "%s\t%t\n", checksum, pinned)
+	}
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// the tests use the address prefixes of the chain, like the binary
+	setupSDKConfig()
+	os.Exit(m.Run())
+}
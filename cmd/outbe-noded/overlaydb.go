@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var errKeyEmpty = errors.New("key cannot be empty")
+
+// overlayDB is a copy-on-write view of a database. Reads fall through to the
+// base database, while writes and deletes are kept in memory and never reach
+// it. It lets commands run state transitions against a node's data without
+// modifying it.
+type overlayDB struct {
+	base dbm.DB
+
+	mu     sync.RWMutex
+	writes map[string]overlayValue
+}
+
+type overlayValue struct {
+	value   []byte
+	deleted bool
+}
+
+var _ dbm.DB = (*overlayDB)(nil)
+
+func newOverlayDB(base dbm.DB) *overlayDB {
+	return &overlayDB{
+		base:   base,
+		writes: make(map[string]overlayValue),
+	}
+}
+
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+
+	db.mu.RLock()
+	v, ok := db.writes[string(key)]
+	db.mu.RUnlock()
+	if ok {
+		if v.deleted {
+			return nil, nil
+		}
+		return v.value, nil
+	}
+
+	return db.base.Get(key)
+}
+
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	v, err := db.Get(key)
+	return v != nil, err
+}
+
+func (db *overlayDB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.writes[string(key)] = overlayValue{value: bytes.Clone(value)}
+	return nil
+}
+
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *overlayDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.writes[string(key)] = overlayValue{deleted: true}
+	return nil
+}
+
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+// newIterator merges an iterator of the base database with a snapshot of the
+// in-memory writes in the domain, the latter taking precedence.
+func (db *overlayDB) newIterator(start, end []byte, reverse bool) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errKeyEmpty
+	}
+
+	var (
+		base dbm.Iterator
+		err  error
+	)
+	if reverse {
+		base, err = db.base.ReverseIterator(start, end)
+	} else {
+		base, err = db.base.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	db.mu.RLock()
+	entries := make([]overlayEntry, 0)
+	for k, v := range db.writes {
+		key := []byte(k)
+		if (start != nil && bytes.Compare(key, start) < 0) || (end != nil && bytes.Compare(key, end) >= 0) {
+			continue
+		}
+		entries = append(entries, overlayEntry{key: key, overlayValue: v})
+	}
+	db.mu.RUnlock()
+
+	it := &overlayIterator{
+		start:   start,
+		end:     end,
+		reverse: reverse,
+		base:    base,
+		entries: entries,
+	}
+	sort.Slice(entries, func(i, j int) bool { return it.compare(entries[i].key, entries[j].key) < 0 })
+	it.advance()
+
+	return it, nil
+}
+
+func (db *overlayDB) Close() error {
+	return db.base.Close()
+}
+
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+func (db *overlayDB) NewBatchWithSize(_ int) dbm.Batch {
+	return db.NewBatch()
+}
+
+func (db *overlayDB) Print() error {
+	return db.base.Print()
+}
+
+func (db *overlayDB) Stats() map[string]string {
+	return db.base.Stats()
+}
+
+type overlayEntry struct {
+	key []byte
+	overlayValue
+}
+
+type overlayIterator struct {
+	start, end []byte
+	reverse    bool
+
+	base    dbm.Iterator
+	entries []overlayEntry
+
+	key, value []byte
+	valid      bool
+}
+
+var _ dbm.Iterator = (*overlayIterator)(nil)
+
+// compare orders keys in iteration order.
+func (it *overlayIterator) compare(a, b []byte) int {
+	if it.reverse {
+		return bytes.Compare(b, a)
+	}
+	return bytes.Compare(a, b)
+}
+
+// advance moves to the next visible key, skipping deleted ones and base keys
+// shadowed by a write.
+func (it *overlayIterator) advance() {
+	for {
+		hasBase := it.base.Valid()
+		if !hasBase && len(it.entries) == 0 {
+			it.valid = false
+			return
+		}
+
+		if len(it.entries) > 0 && (!hasBase || it.compare(it.entries[0].key, it.base.Key()) <= 0) {
+			e := it.entries[0]
+			it.entries = it.entries[1:]
+			if hasBase && bytes.Equal(e.key, it.base.Key()) {
+				it.base.Next()
+			}
+			if e.deleted {
+				continue
+			}
+
+			it.key, it.value, it.valid = e.key, e.value, true
+			return
+		}
+
+		it.key, it.value, it.valid = bytes.Clone(it.base.Key()), bytes.Clone(it.base.Value()), true
+		it.base.Next()
+		return
+	}
+}
+
+func (it *overlayIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+func (it *overlayIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *overlayIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.advance()
+}
+
+func (it *overlayIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+func (it *overlayIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+func (it *overlayIterator) Error() error {
+	return it.base.Error()
+}
+
+func (it *overlayIterator) Close() error {
+	return it.base.Close()
+}
+
+type overlayBatch struct {
+	db  *overlayDB
+	ops []overlayEntry
+}
+
+var _ dbm.Batch = (*overlayBatch)(nil)
+
+func (b *overlayBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+
+	b.ops = append(b.ops, overlayEntry{key: bytes.Clone(key), overlayValue: overlayValue{value: bytes.Clone(value)}})
+	return nil
+}
+
+func (b *overlayBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+
+	b.ops = append(b.ops, overlayEntry{key: bytes.Clone(key), overlayValue: overlayValue{deleted: true}})
+	return nil
+}
+
+func (b *overlayBatch) Write() error {
+	b.db.mu.Lock()
+	defer b.db.mu.Unlock()
+
+	for _, op := range b.ops {
+		b.db.writes[string(op.key)] = op.overlayValue
+	}
+	b.ops = nil
+
+	return nil
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() error {
+	b.ops = nil
+	return nil
+}
+
+func (b *overlayBatch) GetByteSize() (int, error) {
+	size := 0
+	for _, op := range b.ops {
+		size += len(op.key) + len(op.value)
+	}
+
+	return size, nil
+}
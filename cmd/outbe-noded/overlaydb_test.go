@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlayDB(t *testing.T) {
+	base := dbm.NewMemDB()
+	for _, k := range []string{"a", "b", "c", "d"} {
+		require.NoError(t, base.Set([]byte(k), []byte("base-"+k)))
+	}
+
+	db := newOverlayDB(base)
+	require.NoError(t, db.Set([]byte("b"), []byte("new-b")))
+	require.NoError(t, db.Delete([]byte("c")))
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("e"), []byte("new-e")))
+	require.NoError(t, batch.Delete([]byte("a")))
+	require.NoError(t, batch.Write())
+
+	v, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("new-b"), v)
+
+	has, err := db.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	collect := func(it dbm.Iterator, err error) []string {
+		require.NoError(t, err)
+		defer it.Close()
+
+		var kvs []string
+		for ; it.Valid(); it.Next() {
+			kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+		}
+		return kvs
+	}
+
+	require.Equal(t, []string{"b=new-b", "d=base-d", "e=new-e"}, collect(db.Iterator(nil, nil)))
+	require.Equal(t, []string{"e=new-e", "d=base-d", "b=new-b"}, collect(db.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"b=new-b", "d=base-d"}, collect(db.Iterator([]byte("a"), []byte("e"))))
+
+	// the base database is untouched
+	require.Equal(t, []string{"a=base-a", "b=base-b", "c=base-c", "d=base-d"}, collect(base.Iterator(nil, nil)))
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+const (
+	flagHeightA = "height-a"
+	flagHeightB = "height-b"
+	flagGenesis = "genesis"
+	flagStores  = "stores"
+	flagLimit   = "limit"
+)
+
+// stateSide returns the version of a store on one side of a state diff, nil
+// if the store does not exist there.
+type stateSide func(key *storetypes.KVStoreKey) (storetypes.KVStore, error)
+
+func stateDiffCommand(chainApp *app.ChainApp) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [data-dir-a] [data-dir-b]",
+		Short: "Print the decoded key/value differences between two versions of the application state",
+		Long: `Compare two versions of the application state store by store and print the
+differing key/values, decoded by the store decoders of the modules.
+
+The versions are either two application databases, given as the data dirs
+holding them, two heights of one application database, or, with --genesis, the
+state after the first empty block of chains started from two genesis files. A
+height of 0 is the latest one. The databases are opened read-only, so the nodes
+must be stopped.`,
+		Example: fmt.Sprintf(`%[1]s debug state-diff ~/.outbe-node/data /tmp/peer/data --height-a 1200 --height-b 1200
+%[1]s debug state-diff ~/.outbe-node/data --height-a 1199 --height-b 1200 --stores bank,staking
+%[1]s debug state-diff --genesis export-a.json export-b.json`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			heightA, _ := cmd.Flags().GetInt64(flagHeightA)
+			heightB, _ := cmd.Flags().GetInt64(flagHeightB)
+			genesis, _ := cmd.Flags().GetBool(flagGenesis)
+			stores, _ := cmd.Flags().GetStringSlice(flagStores)
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+
+			keys, err := diffStoreKeys(chainApp, stores)
+			if err != nil {
+				return err
+			}
+
+			var sideA, sideB stateSide
+			switch {
+			case genesis:
+				if len(args) != 2 {
+					return fmt.Errorf("--%s needs two genesis files", flagGenesis)
+				}
+				if sideA, err = genesisStateSide(args[0]); err != nil {
+					return fmt.Errorf("%s: %w", args[0], err)
+				}
+				if sideB, err = genesisStateSide(args[1]); err != nil {
+					return fmt.Errorf("%s: %w", args[1], err)
+				}
+
+			case len(args) == 1:
+				if heightA == heightB {
+					return fmt.Errorf("comparing one data dir needs two different --%s and --%s", flagHeightA, flagHeightB)
+				}
+				rs, closeDB, err := loadStateStore(args[0], serverCtx.Viper, keys)
+				if err != nil {
+					return err
+				}
+				defer closeDB()
+				sideA, sideB = storeVersionSide(rs, heightA), storeVersionSide(rs, heightB)
+
+			default:
+				rsA, closeA, err := loadStateStore(args[0], serverCtx.Viper, keys)
+				if err != nil {
+					return err
+				}
+				defer closeA()
+				rsB, closeB, err := loadStateStore(args[1], serverCtx.Viper, keys)
+				if err != nil {
+					return err
+				}
+				defer closeB()
+				sideA, sideB = storeVersionSide(rsA, heightA), storeVersionSide(rsB, heightB)
+			}
+
+			return printStateDiff(cmd.OutOrStdout(), chainApp, keys, sideA, sideB, limit)
+		},
+	}
+
+	cmd.Flags().Int64(flagHeightA, 0, "The height of the first version, 0 for the latest")
+	cmd.Flags().Int64(flagHeightB, 0, "The height of the second version, 0 for the latest")
+	cmd.Flags().Bool(flagGenesis, false, "Compare the state chains started from two genesis files have after the first block")
+	cmd.Flags().StringSlice(flagStores, nil, "Comma-separated list of stores to compare, all if empty")
+	cmd.Flags().Int(flagLimit, 100, "The most differences printed per store, 0 for all")
+
+	return cmd
+}
+
+// diffStoreKeys returns the KV store keys of the app with the given names, or
+// all of them.
+func diffStoreKeys(chainApp *app.ChainApp, names []string) ([]*storetypes.KVStoreKey, error) {
+	var keys []*storetypes.KVStoreKey
+	for _, key := range chainApp.GetStoreKeys() {
+		if kvKey, ok := key.(*storetypes.KVStoreKey); ok && (len(names) == 0 || slices.Contains(names, key.Name())) {
+			keys = append(keys, kvKey)
+		}
+	}
+
+	if len(names) > len(keys) {
+		for _, name := range names {
+			if chainApp.GetKey(name) == nil {
+				return nil, fmt.Errorf("store %s does not exist", name)
+			}
+		}
+	}
+
+	return keys, nil
+}
+
+// loadStateStore opens the application database of a data dir read-only and
+// loads its stores.
+func loadStateStore(dataDir string, appOpts servertypes.AppOptions, keys []*storetypes.KVStoreKey) (*rootmulti.Store, func(), error) {
+	db, err := openReadOnlyDB(dataDir, server.GetAppDBBackend(appOpts))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open application database in %s: %w", dataDir, err)
+	}
+	overlay := newOverlayDB(db)
+
+	rs := rootmulti.NewStore(overlay, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.SetIAVLDisableFastNode(true)
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+
+	if err := rs.LoadLatestVersion(); err != nil {
+		overlay.Close()
+		return nil, nil, fmt.Errorf("failed to load application state in %s: %w", dataDir, err)
+	}
+
+	return rs, func() { overlay.Close() }, nil
+}
+
+// storeVersionSide reads the stores at the given height, 0 for the latest.
+func storeVersionSide(rs *rootmulti.Store, height int64) stateSide {
+	if height == 0 {
+		height = rs.LastCommitID().Version
+	}
+
+	return func(key *storetypes.KVStoreKey) (storetypes.KVStore, error) {
+		store, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(height)
+		if err == nil {
+			return store, nil
+		}
+
+		// stores added by an upgrade after the height do not exist in it
+		info, infoErr := rs.GetCommitInfo(height)
+		if infoErr != nil {
+			return nil, fmt.Errorf("height %d: %w", height, infoErr)
+		}
+		for _, storeInfo := range info.StoreInfos {
+			if storeInfo.Name == key.Name() {
+				return nil, fmt.Errorf("store %s at height %d: %w", key.Name(), height, err)
+			}
+		}
+		return nil, nil
+	}
+}
+
+// genesisStateSide reads the stores a new app commits after init chain from a
+// genesis file and an empty first block.
+func genesisStateSide(genesisFile string) (stateSide, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return nil, err
+	}
+	if appGenesis.Consensus == nil || appGenesis.Consensus.Params == nil {
+		return nil, fmt.Errorf("genesis has no consensus params")
+	}
+	consensusParams := appGenesis.Consensus.Params.ToProto()
+
+	home, err := os.MkdirTemp("", "state-diff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+
+	chainApp := app.NewChainApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.NewAppOptionsWithFlagHome(home), nil,
+		baseapp.SetChainID(appGenesis.ChainID),
+	)
+
+	if _, err := chainApp.InitChain(&abci.RequestInitChain{
+		Time:            appGenesis.GenesisTime,
+		ChainId:         appGenesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   appGenesis.AppState,
+		InitialHeight:   appGenesis.InitialHeight,
+	}); err != nil {
+		return nil, fmt.Errorf("init chain: %w", err)
+	}
+	// the init chain state is only written with the first block
+	if _, err := chainApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: max(appGenesis.InitialHeight, 1),
+		Time:   appGenesis.GenesisTime,
+	}); err != nil {
+		return nil, fmt.Errorf("first block: %w", err)
+	}
+	if _, err := chainApp.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	ms := chainApp.CommitMultiStore()
+	return func(key *storetypes.KVStoreKey) (storetypes.KVStore, error) {
+		return ms.GetKVStore(chainApp.GetKey(key.Name())), nil
+	}, nil
+}
+
+func printStateDiff(w io.Writer, chainApp *app.ChainApp, keys []*storetypes.KVStoreKey, sideA, sideB stateSide, limit int) error {
+	var differ []string
+	for _, key := range keys {
+		storeA, err := sideA(key)
+		if err != nil {
+			return err
+		}
+		storeB, err := sideB(key)
+		if err != nil {
+			return err
+		}
+
+		diffs, more, err := app.DiffKVStores(storeA, storeB, limit)
+		if err != nil {
+			return fmt.Errorf("store %s: %w", key.Name(), err)
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		differ = append(differ, key.Name())
+
+		count := fmt.Sprint(len(diffs))
+		if more {
+			count = fmt.Sprintf("more than %d", limit)
+		}
+		fmt.Fprintf(w, "store %s: %s differences\n", key.Name(), count)
+
+		for _, d := range diffs {
+			state := "changed"
+			switch {
+			case d.ValueB == nil:
+				state = "only in A"
+			case d.ValueA == nil:
+				state = "only in B"
+			}
+
+			fmt.Fprintf(w, "  key %X (%s)\n", d.Key, state)
+			for _, line := range strings.Split(chainApp.DecodeKVDiff(key.Name(), d), "\n") {
+				fmt.Fprintf(w, "    %s\n", strings.TrimRight(line, " "))
+			}
+		}
+	}
+
+	if len(differ) == 0 {
+		fmt.Fprintf(w, "%d stores compared, no differences\n", len(keys))
+		return nil
+	}
+
+	fmt.Fprintf(w, "%d stores compared, %d differ: %s\n", len(keys), len(differ), strings.Join(differ, ", "))
+	return nil
+}
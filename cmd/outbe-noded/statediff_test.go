@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+func TestStateDiffHeights(t *testing.T) {
+	chainApp := app.NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+	keys, err := diffStoreKeys(chainApp, []string{"bank", "staking"})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	_, err = diffStoreKeys(chainApp, []string{"bank", "nope"})
+	require.ErrorContains(t, err, "store nope does not exist")
+
+	// commit two heights of a bank and staking store
+	dataDir := t.TempDir()
+	db, err := dbm.NewGoLevelDB("application", dataDir, nil)
+	require.NoError(t, err)
+
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range keys {
+		rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, rs.LoadLatestVersion())
+
+	bank := rs.GetKVStore(chainApp.GetKey("bank"))
+	bank.Set([]byte{0xf0}, []byte{1})
+	bank.Set([]byte{0xf1}, []byte{1})
+	rs.Commit()
+	bank.Set([]byte{0xf1}, []byte{2})
+	bank.Set([]byte{0xf2}, []byte{2})
+	rs.Commit()
+	require.NoError(t, db.Close())
+
+	rs, closeDB, err := loadStateStore(dataDir, simtestutil.AppOptionsMap{}, keys)
+	require.NoError(t, err)
+	defer closeDB()
+
+	var out bytes.Buffer
+	require.NoError(t, printStateDiff(&out, chainApp, keys, storeVersionSide(rs, 1), storeVersionSide(rs, 0), 0))
+	require.Equal(t, `store bank: 2 differences
+  key F1 (changed)
+    A: 01
+    B: 02
+  key F2 (only in B)
+    A:
+    B: 02
+2 stores compared, 1 differ: bank
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, printStateDiff(&out, chainApp, keys, storeVersionSide(rs, 2), storeVersionSide(rs, 0), 0))
+	require.Equal(t, "2 stores compared, no differences\n", out.String())
+
+	_, err = storeVersionSide(rs, 3)(keys[0])
+	require.Error(t, err)
+}
+
+func TestStateDiffGenesis(t *testing.T) {
+	chainApp := app.NewChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, false, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()), nil)
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	// two genesis files funding a different amount
+	writeGenesis := func(amount, initialHeight int64) string {
+		priv := secp256k1.GenPrivKeyFromSecret([]byte("state-diff"))
+		acc := authtypes.NewBaseAccount(priv.PubKey().Address().Bytes(), priv.PubKey(), 0, 0)
+		balance := banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+		}
+		genesisState, err := app.GenesisStateWithValSet(chainApp.AppCodec(), chainApp.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+		require.NoError(t, err)
+		appState, err := json.Marshal(genesisState)
+		require.NoError(t, err)
+
+		appGenesis := genutiltypes.NewAppGenesisWithVersion("state-diff-1", appState)
+		appGenesis.Consensus.Params = cmttypes.DefaultConsensusParams()
+		appGenesis.InitialHeight = initialHeight
+		file := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, appGenesis.SaveAs(file))
+		return file
+	}
+
+	keys, err := diffStoreKeys(chainApp, []string{"acc", "bank"})
+	require.NoError(t, err)
+
+	// the first block of a genesis starting above height 1 is its initial
+	// height
+	for _, initialHeight := range []int64{1, 5} {
+		sideA, err := genesisStateSide(writeGenesis(1000, initialHeight))
+		require.NoError(t, err)
+		sideB, err := genesisStateSide(writeGenesis(2000, initialHeight))
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		require.NoError(t, printStateDiff(&out, chainApp, keys, sideA, sideB, 0))
+		require.Contains(t, out.String(), "2 stores compared, 1 differ: bank\n")
+		require.Contains(t, out.String(), "1000")
+		require.Contains(t, out.String(), "2000")
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+// vmDirSkipped are the entries of a wasm VM dir not copied to a temp home: the
+// lock of the VM using the dir and the compiled modules, rebuilt on demand.
+var vmDirSkipped = map[string]bool{
+	"exclusive.lock": true,
+	"cache":          true,
+}
+
+// overrideAppOptions returns the values it holds over the ones of the wrapped
+// app options.
+type overrideAppOptions struct {
+	servertypes.AppOptions
+	values map[string]any
+}
+
+func (o overrideAppOptions) Get(key string) any {
+	if v, ok := o.values[key]; ok {
+		return v
+	}
+
+	return o.AppOptions.Get(key)
+}
+
+// tempHomeAppOptions returns appOpts with the home and the 08-wasm VM dir
+// moved to a temp dir holding a copy of the contract code of the node. The
+// wasm VMs of an app lock and write their dirs, so the apps commands build
+// next to the node use it to leave the node home untouched. The returned func
+// removes the temp dir.
+func tempHomeAppOptions(appOpts servertypes.AppOptions) (servertypes.AppOptions, func(), error) {
+	home := cast.ToString(appOpts.Get(flags.FlagHome))
+	if home == "" {
+		return nil, nil, errors.New("application home is not set")
+	}
+
+	lightClientConfig, err := app.ReadWasmLightClientConfig(appOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tempHome, err := os.MkdirTemp("", "outbe-app-home")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() { _ = os.RemoveAll(tempHome) }
+
+	tempLightClientDir := filepath.Join(tempHome, "data", "08-light-client")
+	for src, dst := range map[string]string{
+		filepath.Join(home, "data", "wasm"): filepath.Join(tempHome, "data", "wasm"),
+		lightClientConfig.VMDir(home):       tempLightClientDir,
+	} {
+		if err := copyVMDir(src, dst); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+	}
+
+	return overrideAppOptions{
+		AppOptions: appOpts,
+		values: map[string]any{
+			flags.FlagHome:             tempHome,
+			app.FlagWasmLightClientDir: tempLightClientDir,
+		},
+	}, cleanup, nil
+}
+
+// copyVMDir copies the wasm VM dir src to dst, without the entries in
+// vmDirSkipped. Nothing is copied if src does not exist.
+func copyVMDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if path == src && errors.Is(err, fs.ErrNotExist) {
+			return filepath.SkipAll
+		}
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if vmDirSkipped[rel] {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+func TestTempHomeAppOptions(t *testing.T) {
+	home := t.TempDir()
+	wasmDir := filepath.Join(home, "data", "wasm")
+	for path, content := range map[string]string{
+		"state/wasm/checksum": "code",
+		"cache/modules/v1":    "module",
+		"exclusive.lock":      "",
+	} {
+		path = filepath.Join(wasmDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	appOpts, cleanup, err := tempHomeAppOptions(simtestutil.NewAppOptionsWithFlagHome(home))
+	require.NoError(t, err)
+
+	tempHome, ok := appOpts.Get(flags.FlagHome).(string)
+	require.True(t, ok)
+	require.NotEqual(t, home, tempHome)
+	require.Equal(t, filepath.Join(tempHome, "data", "08-light-client"), appOpts.Get(app.FlagWasmLightClientDir))
+
+	code, err := os.ReadFile(filepath.Join(tempHome, "data", "wasm", "state", "wasm", "checksum"))
+	require.NoError(t, err)
+	require.Equal(t, "code", string(code))
+	require.NoDirExists(t, filepath.Join(tempHome, "data", "wasm", "cache"))
+	require.NoFileExists(t, filepath.Join(tempHome, "data", "wasm", "exclusive.lock"))
+	// the 08-wasm VM dir of the node does not exist
+	require.NoDirExists(t, filepath.Join(tempHome, "data", "08-light-client"))
+
+	cleanup()
+	require.NoDirExists(t, tempHome)
+	require.FileExists(t, filepath.Join(wasmDir, "state", "wasm", "checksum"))
+}
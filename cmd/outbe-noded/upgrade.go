@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/outbe/outbe-node/app"
+)
+
+// upgradeCommand groups the chain upgrade tooling.
+func upgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "upgrade",
+		Short:                      "Chain upgrade subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(upgradeDryRunCommand())
+
+	return cmd
+}
+
+func upgradeDryRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [name]",
+		Short: "Rehearse a registered upgrade against the node's state without committing",
+		Long: `Open the node's application database read-only, apply the store upgrades and
+the handler of the named upgrade in memory as if it ran in the next block, and
+assert all registered invariants. Nothing is written to the database.
+
+The node must be stopped, or --home must point at a copy of its data.`,
+		Example: fmt.Sprintf("%s upgrade dry-run poa-to-pos --home ~/.outbe-node", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			name := args[0]
+
+			db, err := openReadOnlyDB(cfg.DBDir(), dbm.BackendType(cfg.DBBackend))
+			if err != nil {
+				return fmt.Errorf("failed to open application database: %w", err)
+			}
+			overlay := newOverlayDB(db)
+			defer overlay.Close()
+
+			chainID, err := readChainID(serverCtx.Viper.GetString(flags.FlagChainID), cfg.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			appOpts, cleanup, err := tempHomeAppOptions(serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("failed to set up the application home: %w", err)
+			}
+			defer cleanup()
+
+			start := time.Now()
+			chainApp := app.NewChainApp(serverCtx.Logger, overlay, nil, false, appOpts, nil, baseapp.SetChainID(chainID))
+
+			upgrade, ok := app.GetUpgrade(name)
+			if !ok {
+				return fmt.Errorf("upgrade %s is not registered", name)
+			}
+
+			chainApp.SetStoreLoader(func(ms storetypes.CommitMultiStore) error {
+				return ms.LoadLatestVersionAndUpgrade(&upgrade.StoreUpgrades)
+			})
+			if err := chainApp.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load state with store upgrades: %w", err)
+			}
+			loadTime := time.Since(start)
+
+			res, err := chainApp.DryRunUpgrade(name)
+			printDryRunResult(cmd.OutOrStdout(), res, loadTime, err)
+
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID, read from the genesis file if empty")
+
+	return cmd
+}
+
+// openReadOnlyDB opens the application database without write access where
+// the backend supports it. Writes are caught by the overlay in any case.
+func openReadOnlyDB(dir string, backend dbm.BackendType) (dbm.DB, error) {
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dir, &opt.Options{ReadOnly: true})
+	}
+
+	return dbm.NewDB("application", backend, dir)
+}
+
+// readChainID returns the chain id flag or, if unset, the chain id of the
+// genesis file.
+func readChainID(flagValue, genesisFile string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+
+	reader, err := os.Open(filepath.Clean(genesisFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to read chain id from genesis: %w", err)
+	}
+	defer reader.Close()
+
+	return genutiltypes.ParseChainIDFromGenesis(reader)
+}
+
+func printDryRunResult(w io.Writer, res app.UpgradeDryRunResult, loadTime time.Duration, err error) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	status := "ok"
+	if err != nil {
+		status = "failed"
+	}
+
+	fmt.Fprintf(tw, "upgrade:\t%s\n", res.Name)
+	fmt.Fprintf(tw, "height:\t%d\n", res.Height)
+	fmt.Fprintf(tw, "status:\t%s\n", status)
+	fmt.Fprintf(tw, "gas consumed:\t%d\n", res.GasConsumed)
+	fmt.Fprintf(tw, "load time:\t%s\n", loadTime)
+	fmt.Fprintf(tw, "upgrade time:\t%s\n", res.UpgradeTime)
+	fmt.Fprintf(tw, "invariants time:\t%s\n", res.InvariantsTime)
+
+	if len(res.ToVersions) == 0 {
+		return
+	}
+
+	fmt.Fprintln(tw, "module versions:")
+	modules := make([]string, 0, len(res.ToVersions))
+	for m := range res.ToVersions {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+
+	for _, m := range modules {
+		from, ok := res.FromVersions[m]
+		switch {
+		case !ok:
+			fmt.Fprintf(tw, "  %s\t%d\t(added)\n", m, res.ToVersions[m])
+		case from != res.ToVersions[m]:
+			fmt.Fprintf(tw, "  %s\t%d\t(from %d)\n", m, res.ToVersions[m], from)
+		default:
+			fmt.Fprintf(tw, "  %s\t%d\n", m, res.ToVersions[m])
+		}
+	}
+}
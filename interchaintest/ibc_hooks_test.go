@@ -0,0 +1,203 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/interchaintest/v8"
+	"github.com/strangelove-ventures/interchaintest/v8/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v8/ibc"
+	interchaintestrelayer "github.com/strangelove-ventures/interchaintest/v8/relayer"
+	"github.com/strangelove-ventures/interchaintest/v8/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v8/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+)
+
+// HooksCountResponse is the get_count response of the ibc-hooks counter
+// contract, which keeps a counter per sender.
+type HooksCountResponse struct {
+	Data *struct {
+		Count int64 `json:"count"`
+	} `json:"data"`
+}
+
+// HooksTotalFundsResponse is the get_total_funds response of the ibc-hooks
+// counter contract.
+type HooksTotalFundsResponse struct {
+	Data *struct {
+		TotalFunds []struct {
+			Denom  string `json:"denom"`
+			Amount string `json:"amount"`
+		} `json:"total_funds"`
+	} `json:"data"`
+}
+
+// intermediateSender returns the address ibc-hooks executes contracts with
+// for a sender on the other end of channel.
+func intermediateSender(t *testing.T, channel, sender string) string {
+	addr, err := sdk.Bech32ifyAddressBytes(Bech32, address.Hash("ibc-wasm-hook-intermediary", []byte(channel+"/"+sender)))
+	require.NoError(t, err)
+	return addr
+}
+
+func TestIBCHooks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+	client, network := interchaintest.DockerSetup(t)
+
+	cs := &DefaultChainSpec
+	cs.ModifyGenesis = cosmos.ModifyGenesis([]cosmos.GenesisKV{cosmos.NewGenesisKV("app_state.ratelimit.blacklisted_denoms", []string{})})
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		cs,
+		&SecondDefaultChainSpec,
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	chainA, chainB := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	r := interchaintest.NewBuiltinRelayerFactory(
+		ibc.CosmosRly,
+		zaptest.NewLogger(t, zaptest.Level(zapcore.DebugLevel)),
+		interchaintestrelayer.CustomDockerImage(RelayerRepo, RelayerVersion, "100:1000"),
+		interchaintestrelayer.StartupFlags("--processor", "events", "--block-history", "200"),
+	).Build(t, client, network)
+
+	ic := interchaintest.NewInterchain().
+		AddChain(chainA).
+		AddChain(chainB).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chainA,
+			Chain2:  chainB,
+			Relayer: r,
+			Path:    ibcPath,
+		})
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:         t.Name(),
+		Client:           client,
+		NetworkID:        network,
+		SkipPathCreation: false,
+	}))
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, chainA))
+
+	fundAmount := math.NewInt(10_000_000)
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", fundAmount, chainA, chainB)
+	userA := users[0]
+	userB := users[1]
+
+	aInfo, err := r.GetChannels(ctx, eRep, chainA.Config().ChainID)
+	require.NoError(t, err)
+	aChannelID, err := getTransferChannel(aInfo)
+	require.NoError(t, err)
+
+	bInfo, err := r.GetChannels(ctx, eRep, chainB.Config().ChainID)
+	require.NoError(t, err)
+	bChannelID, err := getTransferChannel(bInfo)
+	require.NoError(t, err)
+
+	_, contract := SetupContract(t, ctx, chainA, userA.KeyName(), "contracts/ibchooks_counter.wasm", `{"count":0}`)
+
+	count := func(addr string) int64 {
+		var res HooksCountResponse
+		require.NoError(t, SmartQueryString(t, ctx, chainA, contract, fmt.Sprintf(`{"get_count":{"addr":%q}}`, addr), &res))
+		return res.Data.Count
+	}
+
+	// denom of the tokens of chainB received on chainA
+	bDenomOnA := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, aChannelID, chainB.Config().Denom)).IBCDenom()
+	amountToSend := math.NewInt(1_000)
+	wasmMemo := func(msg string) string {
+		return fmt.Sprintf(`{"wasm":{"contract":%q,"msg":%s}}`, contract, msg)
+	}
+
+	t.Run("wasm memo executes the contract with the received funds", func(t *testing.T) {
+		transfer := ibc.WalletAmount{Address: contract, Denom: chainB.Config().Denom, Amount: amountToSend}
+
+		for i := 0; i < 2; i++ {
+			_, err := chainB.SendIBCTransfer(ctx, bChannelID, userB.KeyName(), transfer, ibc.TransferOptions{Memo: wasmMemo(`{"increment":{}}`)})
+			require.NoError(t, err)
+			require.NoError(t, r.Flush(ctx, eRep, ibcPath, bChannelID))
+		}
+
+		// the contract is called by the intermediate sender of userB, the
+		// first increment of a sender initializes its counter to 0
+		sender := intermediateSender(t, aChannelID, userB.FormattedAddress())
+		require.Equal(t, int64(1), count(sender))
+
+		var funds HooksTotalFundsResponse
+		require.NoError(t, SmartQueryString(t, ctx, chainA, contract, fmt.Sprintf(`{"get_total_funds":{"addr":%q}}`, sender), &funds))
+		require.Len(t, funds.Data.TotalFunds, 1)
+		require.Equal(t, bDenomOnA, funds.Data.TotalFunds[0].Denom)
+		require.Equal(t, amountToSend.MulRaw(2).String(), funds.Data.TotalFunds[0].Amount)
+
+		bal, err := chainA.GetBalance(ctx, contract, bDenomOnA)
+		require.NoError(t, err)
+		require.True(t, bal.Equal(amountToSend.MulRaw(2)))
+	})
+
+	t.Run("failed contract call refunds the sender", func(t *testing.T) {
+		userBInitial, err := chainB.GetBalance(ctx, userB.FormattedAddress(), chainB.Config().Denom)
+		require.NoError(t, err)
+
+		transfer := ibc.WalletAmount{Address: contract, Denom: chainB.Config().Denom, Amount: amountToSend}
+		_, err = chainB.SendIBCTransfer(ctx, bChannelID, userB.KeyName(), transfer, ibc.TransferOptions{Memo: wasmMemo(`{"unknown":{}}`)})
+		require.NoError(t, err)
+		require.NoError(t, r.Flush(ctx, eRep, ibcPath, bChannelID))
+
+		userBBal, err := chainB.GetBalance(ctx, userB.FormattedAddress(), chainB.Config().Denom)
+		require.NoError(t, err)
+		require.True(t, userBBal.Equal(userBInitial))
+
+		bal, err := chainA.GetBalance(ctx, contract, bDenomOnA)
+		require.NoError(t, err)
+		require.True(t, bal.Equal(amountToSend.MulRaw(2)))
+	})
+
+	// the counter contract counts the callbacks it receives under its own
+	// address: +1 per ack, +10 per timeout
+	callbackMemo := fmt.Sprintf(`{"ibc_callback":%q}`, contract)
+	transfer := ibc.WalletAmount{Address: userB.FormattedAddress(), Denom: chainA.Config().Denom, Amount: amountToSend}
+
+	t.Run("ack is reported to the callback contract", func(t *testing.T) {
+		_, err := chainA.SendIBCTransfer(ctx, aChannelID, userA.KeyName(), transfer, ibc.TransferOptions{Memo: callbackMemo})
+		require.NoError(t, err)
+		require.NoError(t, r.Flush(ctx, eRep, ibcPath, aChannelID))
+
+		require.Equal(t, int64(1), count(contract))
+	})
+
+	t.Run("timeout is reported to the callback contract", func(t *testing.T) {
+		_, err := chainA.SendIBCTransfer(ctx, aChannelID, userA.KeyName(), transfer, ibc.TransferOptions{
+			Memo:             callbackMemo,
+			Timeout:          &ibc.IBCTimeout{NanoSeconds: uint64(time.Now().Add(10 * time.Second).UnixNano())},
+			AbsoluteTimeouts: true,
+		})
+		require.NoError(t, err)
+
+		// let the packet expire before relaying MsgTimeout to chainA
+		time.Sleep(15 * time.Second)
+		require.NoError(t, testutil.WaitForBlocks(ctx, 2, chainA, chainB))
+		require.NoError(t, r.Flush(ctx, eRep, ibcPath, aChannelID))
+
+		require.Equal(t, int64(11), count(contract))
+	})
+}
@@ -2,7 +2,9 @@ package e2e
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -119,3 +121,166 @@ func TestIBCBasic(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, bNewBal.Equal(amountToSend))
 }
+
+func TestIBCFeeMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+	client, network := interchaintest.DockerSetup(t)
+
+	cs := &DefaultChainSpec
+	cs.ModifyGenesis = cosmos.ModifyGenesis([]cosmos.GenesisKV{cosmos.NewGenesisKV("app_state.ratelimit.blacklisted_denoms", []string{})})
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		cs,
+		&SecondDefaultChainSpec,
+	})
+
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	chainA, chainB := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain)
+
+	r := interchaintest.NewBuiltinRelayerFactory(
+		ibc.CosmosRly,
+		zaptest.NewLogger(t, zaptest.Level(zapcore.DebugLevel)),
+		interchaintestrelayer.CustomDockerImage(RelayerRepo, RelayerVersion, "100:1000"),
+		interchaintestrelayer.StartupFlags("--processor", "events", "--block-history", "200"),
+	).Build(t, client, network)
+
+	// open an ICS-29 fee-enabled transfer channel
+	ic := interchaintest.NewInterchain().
+		AddChain(chainA).
+		AddChain(chainB).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chainA,
+			Chain2:  chainB,
+			Relayer: r,
+			Path:    ibcPath,
+			CreateChannelOpts: ibc.CreateChannelOptions{
+				SourcePortName: transfertypes.PortID,
+				DestPortName:   transfertypes.PortID,
+				Order:          ibc.Unordered,
+				Version:        `{"fee_version":"ics29-1","app_version":"ics20-1"}`,
+			},
+		})
+
+	require.NoError(t, ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:         t.Name(),
+		Client:           client,
+		NetworkID:        network,
+		SkipPathCreation: false,
+	}))
+
+	require.NoError(t, testutil.WaitForBlocks(ctx, 5, chainA))
+
+	fundAmount := math.NewInt(10_000_000)
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", fundAmount, chainA, chainB)
+	userA := users[0]
+	userB := users[1]
+
+	aInfo, err := r.GetChannels(ctx, eRep, chainA.Config().ChainID)
+	require.NoError(t, err)
+	aChannelID, err := getTransferChannel(aInfo)
+	require.NoError(t, err)
+
+	bInfo, err := r.GetChannels(ctx, eRep, chainB.Config().ChainID)
+	require.NoError(t, err)
+	bChannelID, err := getTransferChannel(bInfo)
+	require.NoError(t, err)
+
+	feeEscrow, err := chainA.GetModuleAddress(ctx, "feeibc")
+	require.NoError(t, err)
+
+	// the recv fee is paid to the relayer of MsgRecvPacket on chainB, the ack
+	// fee to the relayer of MsgAcknowledgement on chainA
+	relayerA, ok := r.GetWallet(chainA.Config().ChainID)
+	require.True(t, ok)
+	relayerB, ok := r.GetWallet(chainB.Config().ChainID)
+	require.True(t, ok)
+
+	denom := chainA.Config().Denom
+	balance := func(address string) math.Int {
+		bal, err := chainA.GetBalance(ctx, address, denom)
+		require.NoError(t, err)
+		return bal
+	}
+
+	recvFee, ackFee, timeoutFee := math.NewInt(1_000), math.NewInt(500), math.NewInt(250)
+	payPacketFee := func(sequence uint64) {
+		_, err := chainA.GetNode().ExecTx(ctx, userA.KeyName(),
+			"ibc-fee", "pay-packet-fee", transfertypes.PortID, aChannelID, fmt.Sprint(sequence),
+			"--recv-fee", recvFee.String()+denom,
+			"--ack-fee", ackFee.String()+denom,
+			"--timeout-fee", timeoutFee.String()+denom,
+		)
+		require.NoError(t, err)
+	}
+	totalFee := recvFee.Add(ackFee).Add(timeoutFee)
+
+	amountToSend := math.NewInt(1_000_000)
+	transfer := ibc.WalletAmount{
+		Address: userB.FormattedAddress(),
+		Denom:   denom,
+		Amount:  amountToSend,
+	}
+
+	t.Run("relayed packet pays recv and ack fees", func(t *testing.T) {
+		userAInitial := balance(userA.FormattedAddress())
+		relayersInitial := balance(relayerA.FormattedAddress())
+		if relayerB.FormattedAddress() != relayerA.FormattedAddress() {
+			relayersInitial = relayersInitial.Add(balance(relayerB.FormattedAddress()))
+		}
+
+		tx, err := chainA.SendIBCTransfer(ctx, aChannelID, userA.KeyName(), transfer, ibc.TransferOptions{})
+		require.NoError(t, err)
+		payPacketFee(tx.Packet.Sequence)
+
+		// fees are held in escrow until the packet lifecycle completes
+		require.True(t, balance(feeEscrow).Equal(totalFee))
+
+		require.NoError(t, r.Flush(ctx, eRep, ibcPath, aChannelID))
+
+		require.True(t, balance(feeEscrow).IsZero())
+
+		// the unused timeout fee is refunded
+		expected := userAInitial.Sub(amountToSend).Sub(recvFee).Sub(ackFee)
+		require.True(t, balance(userA.FormattedAddress()).Equal(expected))
+
+		relayers := balance(relayerA.FormattedAddress())
+		if relayerB.FormattedAddress() != relayerA.FormattedAddress() {
+			relayers = relayers.Add(balance(relayerB.FormattedAddress()))
+		}
+		require.True(t, relayers.Sub(relayersInitial).Equal(recvFee.Add(ackFee)))
+
+		dstIbcDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, bChannelID, denom)).IBCDenom()
+		bBal, err := chainB.GetBalance(ctx, userB.FormattedAddress(), dstIbcDenom)
+		require.NoError(t, err)
+		require.True(t, bBal.Equal(amountToSend))
+	})
+
+	t.Run("timed out packet refunds transfer, recv and ack fees", func(t *testing.T) {
+		userAInitial := balance(userA.FormattedAddress())
+		relayerAInitial := balance(relayerA.FormattedAddress())
+
+		tx, err := chainA.SendIBCTransfer(ctx, aChannelID, userA.KeyName(), transfer, ibc.TransferOptions{
+			Timeout:          &ibc.IBCTimeout{NanoSeconds: uint64(time.Now().Add(10 * time.Second).UnixNano())},
+			AbsoluteTimeouts: true,
+		})
+		require.NoError(t, err)
+		payPacketFee(tx.Packet.Sequence)
+		require.True(t, balance(feeEscrow).Equal(totalFee))
+
+		// let the packet expire before relaying MsgTimeout to chainA
+		time.Sleep(15 * time.Second)
+		require.NoError(t, testutil.WaitForBlocks(ctx, 2, chainA, chainB))
+		require.NoError(t, r.Flush(ctx, eRep, ibcPath, aChannelID))
+
+		require.True(t, balance(feeEscrow).IsZero())
+		require.True(t, balance(userA.FormattedAddress()).Equal(userAInitial.Sub(timeoutFee)))
+		require.True(t, balance(relayerA.FormattedAddress()).Sub(relayerAInitial).Equal(timeoutFee))
+	})
+}
@@ -229,4 +229,40 @@ func TestPacketForwardMiddleware(t *testing.T) {
 		require.Equal(t, transferAmount.Int64(), firstHopEscrowBalance.Int64())
 		require.Equal(t, transferAmount.Int64(), secondHopEscrowBalance.Int64())
 	})
+
+	t.Run("forwarding params and in-flight packets are queryable", func(t *testing.T) {
+		stdout, _, err := chainB.GetNode().ExecQuery(ctx, "forwarding", "params")
+		require.NoError(t, err)
+
+		var params ForwardingParamsResponse
+		require.NoError(t, json.Unmarshal(stdout, &params))
+		require.Equal(t, "600s", params.Params.ForwardTimeout)
+		require.Equal(t, "1000", params.NodeConfig.MaxInFlightPacketsLimit)
+
+		// the forward of the multi-hop transfer was acknowledged
+		stdout, _, err = chainB.GetNode().ExecQuery(ctx, "forwarding", "in-flight-packets")
+		require.NoError(t, err)
+
+		var inFlight struct {
+			Packets []json.RawMessage `json:"packets"`
+		}
+		require.NoError(t, json.Unmarshal(stdout, &inFlight))
+		require.Empty(t, inFlight.Packets)
+	})
+}
+
+// ForwardingParams are the packet-forward params of the forwarding module.
+type ForwardingParams struct {
+	RetriesOnTimeout int    `json:"retries_on_timeout"`
+	ForwardTimeout   string `json:"forward_timeout"`
+	FeePercentage    string `json:"fee_percentage"`
+}
+
+// ForwardingParamsResponse is the params query response of the forwarding
+// module.
+type ForwardingParamsResponse struct {
+	Params     ForwardingParams `json:"params"`
+	NodeConfig struct {
+		MaxInFlightPacketsLimit string `json:"max_in_flight_packets_limit"`
+	} `json:"node_config"`
 }
@@ -0,0 +1,122 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// Version is the version of the chain bindings schema in
+// wasmbinding/schema. It follows semver: new variants bump the minor
+// version, changes to existing variants bump the major version.
+const Version = "1.0.0"
+
+// ChainQuery is the custom query contracts send to read chain native state.
+// Its variants do not overlap with TokenFactoryQuery, so both share the
+// custom query entry point.
+type ChainQuery struct {
+	Version              *GetVersion           `json:"version,omitempty"`
+	DenomMetadata        *DenomMetadata        `json:"denom_metadata,omitempty"`
+	RateLimit            *GetRateLimit         `json:"rate_limit,omitempty"`
+	RateLimits           *GetRateLimits        `json:"rate_limits,omitempty"`
+	CircuitBreakerStatus *CircuitBreakerStatus `json:"circuit_breaker_status,omitempty"`
+	ModuleParams         *ModuleParams         `json:"module_params,omitempty"`
+	InterchainAccount    *InterchainAccount    `json:"interchain_account,omitempty"`
+}
+
+type GetVersion struct{}
+
+type DenomMetadata struct {
+	Denom string `json:"denom"`
+}
+
+type GetRateLimit struct {
+	Denom     string `json:"denom"`
+	ChannelID string `json:"channel_id"`
+}
+
+// GetRateLimits lists the rate limits, optionally only those of Denom.
+type GetRateLimits struct {
+	Denom string `json:"denom,omitempty"`
+}
+
+type CircuitBreakerStatus struct {
+	MsgTypeURL string `json:"msg_type_url"`
+}
+
+type ModuleParams struct {
+	Module string `json:"module"`
+}
+
+type InterchainAccount struct {
+	Owner        string `json:"owner"`
+	ConnectionID string `json:"connection_id"`
+}
+
+type VersionResponse struct {
+	Version string `json:"version"`
+	// Modules are the modules whose params can be queried.
+	Modules []string `json:"modules"`
+}
+
+type DenomMetadataResponse struct {
+	Metadata *Metadata        `json:"metadata,omitempty"`
+	Supply   wasmvmtypes.Coin `json:"supply"`
+}
+
+type RateLimitResponse struct {
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+}
+
+type RateLimitsResponse struct {
+	RateLimits []RateLimit `json:"rate_limits"`
+}
+
+type CircuitBreakerStatusResponse struct {
+	MsgTypeURL string `json:"msg_type_url"`
+	Allowed    bool   `json:"allowed"`
+}
+
+type ModuleParamsResponse struct {
+	Module string `json:"module"`
+	// Params is the proto JSON encoding of the module params.
+	Params json.RawMessage `json:"params"`
+}
+
+type InterchainAccountResponse struct {
+	Address   string `json:"address"`
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+}
+
+// RateLimit mirrors a rate limit of the rate-limiting module. Amounts and
+// percentages are decimal strings.
+type RateLimit struct {
+	Denom          string `json:"denom"`
+	ChannelID      string `json:"channel_id"`
+	MaxPercentSend string `json:"max_percent_send"`
+	MaxPercentRecv string `json:"max_percent_recv"`
+	DurationHours  uint64 `json:"duration_hours"`
+	Inflow         string `json:"inflow"`
+	Outflow        string `json:"outflow"`
+	ChannelValue   string `json:"channel_value"`
+}
+
+// ChainMsg is the custom message contracts send to act on chain native
+// modules. Its variants do not overlap with TokenFactoryMsg.
+//
+// Every variant is executed as an sdk message signed by the contract, through
+// the router the message filter guards. There is no variant burning from a
+// module account, as no message lets an account burn the coins of a module:
+// contracts burn their own coins with BankMsg::Burn and the factory denoms
+// they administer with TokenFactoryMsg::BurnTokens.
+type ChainMsg struct {
+	RegisterInterchainAccount *RegisterInterchainAccount `json:"register_interchain_account,omitempty"`
+}
+
+// RegisterInterchainAccount opens an interchain account owned by the
+// contract. An empty Version uses the default ICS-27 metadata.
+type RegisterInterchainAccount struct {
+	ConnectionID string `json:"connection_id"`
+	Version      string `json:"version,omitempty"`
+}
@@ -0,0 +1,58 @@
+package bindings
+
+import "cosmossdk.io/math"
+
+// TokenFactoryMsg is the custom message contracts send to manage factory
+// denoms. It matches the token-bindings / Osmosis JSON schema.
+type TokenFactoryMsg struct {
+	// CreateDenom creates a denom namespaced under the contract's address.
+	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
+	// ChangeAdmin changes the admin of a denom the contract administers.
+	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+	// MintTokens mints an existing factory denom the contract administers.
+	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
+	// BurnTokens burns an existing factory denom the contract administers.
+	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	// SetMetadata sets the bank metadata of a denom the contract administers.
+	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
+	// ForceTransfer moves tokens of a denom the contract administers.
+	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+}
+
+// CreateDenom creates a new factory denom of the form
+// factory/{creating contract address}/{subdenom}. The creating contract
+// becomes the admin of the denom.
+type CreateDenom struct {
+	Subdenom string    `json:"subdenom"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// ChangeAdmin changes the admin of a factory denom.
+type ChangeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+type MintTokens struct {
+	Denom         string   `json:"denom"`
+	Amount        math.Int `json:"amount"`
+	MintToAddress string   `json:"mint_to_address"`
+}
+
+type BurnTokens struct {
+	Denom           string   `json:"denom"`
+	Amount          math.Int `json:"amount"`
+	BurnFromAddress string   `json:"burn_from_address"`
+}
+
+type SetMetadata struct {
+	Denom    string   `json:"denom"`
+	Metadata Metadata `json:"metadata"`
+}
+
+type ForceTransfer struct {
+	Denom       string   `json:"denom"`
+	Amount      math.Int `json:"amount"`
+	FromAddress string   `json:"from_address"`
+	ToAddress   string   `json:"to_address"`
+}
@@ -0,0 +1,50 @@
+package bindings
+
+// TokenFactoryQuery is the custom query contracts send to inspect factory
+// denoms. It matches the token-bindings / Osmosis JSON schema.
+type TokenFactoryQuery struct {
+	FullDenom       *FullDenom       `json:"full_denom,omitempty"`
+	Admin           *DenomAdmin      `json:"admin,omitempty"`
+	Metadata        *GetMetadata     `json:"metadata,omitempty"`
+	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
+	Params          *GetParams       `json:"params,omitempty"`
+}
+
+type FullDenom struct {
+	CreatorAddr string `json:"creator_addr"`
+	Subdenom    string `json:"subdenom"`
+}
+
+type GetMetadata struct {
+	Denom string `json:"denom"`
+}
+
+type DenomAdmin struct {
+	Denom string `json:"denom"`
+}
+
+type DenomsByCreator struct {
+	Creator string `json:"creator"`
+}
+
+type GetParams struct{}
+
+type FullDenomResponse struct {
+	Denom string `json:"denom"`
+}
+
+type AdminResponse struct {
+	Admin string `json:"admin"`
+}
+
+type MetadataResponse struct {
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+type DenomsByCreatorResponse struct {
+	Denoms []string `json:"denoms"`
+}
+
+type ParamsResponse struct {
+	Params Params `json:"params"`
+}
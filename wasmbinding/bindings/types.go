@@ -0,0 +1,27 @@
+package bindings
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// Metadata mirrors the bank denom metadata.
+type Metadata struct {
+	Description string      `json:"description"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	Name        string      `json:"name"`
+	Symbol      string      `json:"symbol"`
+}
+
+// DenomUnit mirrors the bank denom unit.
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases"`
+}
+
+// Params mirrors the tokenfactory params.
+type Params struct {
+	DenomCreationFee []wasmvmtypes.Coin `json:"denom_creation_fee"`
+}
@@ -0,0 +1,304 @@
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+	icaauthtypes "github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+const (
+	// GasCostChainQuery is charged for every chain query, on top of the
+	// store reads it performs.
+	GasCostChainQuery uint64 = 1_000
+	// GasCostChainQueryItem is charged for every entry a list query returns.
+	GasCostChainQueryItem uint64 = 100
+	// GasCostChainMsg is charged for every chain message, on top of the
+	// execution of the message itself.
+	GasCostChainMsg uint64 = 10_000
+)
+
+// RateLimitKeeper reads the rate limits of the rate-limiting module.
+type RateLimitKeeper interface {
+	GetRateLimit(ctx sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool)
+	GetAllRateLimits(ctx sdk.Context) []ratelimittypes.RateLimit
+}
+
+// CircuitKeeper reports whether the circuit breaker allows a message type.
+type CircuitKeeper interface {
+	IsAllowed(ctx context.Context, msgURL string) (bool, error)
+}
+
+// ICAAuthKeeper resolves the interchain accounts of owners.
+type ICAAuthKeeper interface {
+	InterchainAccount(ctx sdk.Context, owner, connectionID string) (address, portID, channelID string, err error)
+}
+
+// ParamsQuerier returns the params of a module.
+type ParamsQuerier func(ctx sdk.Context) (proto.Message, error)
+
+// ParamsOf adapts the GetParams method of a keeper to a ParamsQuerier.
+func ParamsOf[P any, PT interface {
+	*P
+	proto.Message
+}](getParams func(context.Context) P) ParamsQuerier {
+	return func(ctx sdk.Context) (proto.Message, error) {
+		params := getParams(ctx)
+		return PT(&params), nil
+	}
+}
+
+// ChainKeepers are the keepers behind the chain bindings. Params lists, by
+// module name, the module params contracts may query.
+type ChainKeepers struct {
+	RateLimit RateLimitKeeper
+	Circuit   CircuitKeeper
+	ICAAuth   ICAAuthKeeper
+	Params    map[string]ParamsQuerier
+}
+
+// paramsModules returns the sorted modules whose params can be queried.
+func (k ChainKeepers) paramsModules() []string {
+	modules := make([]string, 0, len(k.Params))
+	for module := range k.Params {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	return modules
+}
+
+// chainQuery handles the chain query variants. It returns false if request
+// is not a chain query.
+func (qp QueryPlugin) chainQuery(ctx sdk.Context, request json.RawMessage) ([]byte, bool, error) {
+	var contractQuery bindings.ChainQuery
+	if err := json.Unmarshal(request, &contractQuery); err != nil {
+		return nil, false, errorsmod.Wrap(err, "chain query")
+	}
+
+	var (
+		res any
+		err error
+	)
+	switch {
+	case contractQuery.Version != nil:
+		res = bindings.VersionResponse{Version: bindings.Version, Modules: qp.chain.paramsModules()}
+	case contractQuery.DenomMetadata != nil:
+		res, err = qp.GetDenomMetadata(ctx, contractQuery.DenomMetadata.Denom)
+	case contractQuery.RateLimit != nil:
+		res, err = qp.GetRateLimit(ctx, contractQuery.RateLimit.Denom, contractQuery.RateLimit.ChannelID)
+	case contractQuery.RateLimits != nil:
+		res, err = qp.GetRateLimits(ctx, contractQuery.RateLimits.Denom)
+	case contractQuery.CircuitBreakerStatus != nil:
+		res, err = qp.GetCircuitBreakerStatus(ctx, contractQuery.CircuitBreakerStatus.MsgTypeURL)
+	case contractQuery.ModuleParams != nil:
+		res, err = qp.GetModuleParams(ctx, contractQuery.ModuleParams.Module)
+	case contractQuery.InterchainAccount != nil:
+		res, err = qp.GetInterchainAccount(ctx, contractQuery.InterchainAccount.Owner, contractQuery.InterchainAccount.ConnectionID)
+	default:
+		return nil, false, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(GasCostChainQuery, "wasm chain query")
+	if err != nil {
+		return nil, true, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to JSON marshal chain query response: %w", err)
+	}
+
+	return bz, true, nil
+}
+
+// GetDenomMetadata returns the bank metadata and total supply of a denom.
+func (qp QueryPlugin) GetDenomMetadata(ctx sdk.Context, denom string) (*bindings.DenomMetadataResponse, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	res := &bindings.DenomMetadataResponse{}
+	if metadata, found := qp.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		res.Metadata = SdkMetadataToWasm(metadata)
+	}
+
+	supply := qp.bankKeeper.GetSupply(ctx, denom)
+	res.Supply = wasmvmtypes.Coin{Denom: supply.Denom, Amount: supply.Amount.String()}
+
+	return res, nil
+}
+
+// GetRateLimit returns the rate limit of a denom on a channel, if any.
+func (qp QueryPlugin) GetRateLimit(ctx sdk.Context, denom, channelID string) (*bindings.RateLimitResponse, error) {
+	if qp.chain.RateLimit == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "rate limits are not available"}
+	}
+
+	res := &bindings.RateLimitResponse{}
+	if rateLimit, found := qp.chain.RateLimit.GetRateLimit(ctx, denom, channelID); found {
+		parsed := rateLimitToWasm(rateLimit)
+		res.RateLimit = &parsed
+	}
+
+	return res, nil
+}
+
+// GetRateLimits returns the rate limits, optionally only those of a denom.
+func (qp QueryPlugin) GetRateLimits(ctx sdk.Context, denom string) (*bindings.RateLimitsResponse, error) {
+	if qp.chain.RateLimit == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "rate limits are not available"}
+	}
+
+	rateLimits := qp.chain.RateLimit.GetAllRateLimits(ctx)
+	ctx.GasMeter().ConsumeGas(GasCostChainQueryItem*uint64(len(rateLimits)), "wasm chain query items")
+
+	parsed := make([]bindings.RateLimit, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		if denom != "" && (rateLimit.Path == nil || rateLimit.Path.Denom != denom) {
+			continue
+		}
+		parsed = append(parsed, rateLimitToWasm(rateLimit))
+	}
+
+	return &bindings.RateLimitsResponse{RateLimits: parsed}, nil
+}
+
+// GetCircuitBreakerStatus reports whether the circuit breaker allows a
+// message type.
+func (qp QueryPlugin) GetCircuitBreakerStatus(ctx sdk.Context, msgTypeURL string) (*bindings.CircuitBreakerStatusResponse, error) {
+	if qp.chain.Circuit == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "circuit breaker is not available"}
+	}
+
+	if msgTypeURL == "" {
+		return nil, wasmvmtypes.InvalidRequest{Err: "empty msg type url"}
+	}
+
+	allowed, err := qp.chain.Circuit.IsAllowed(ctx, msgTypeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.CircuitBreakerStatusResponse{MsgTypeURL: msgTypeURL, Allowed: allowed}, nil
+}
+
+// GetModuleParams returns the proto JSON params of a module.
+func (qp QueryPlugin) GetModuleParams(ctx sdk.Context, module string) (*bindings.ModuleParamsResponse, error) {
+	getParams, ok := qp.chain.Params[module]
+	if !ok {
+		return nil, wasmvmtypes.InvalidRequest{
+			Err: fmt.Sprintf("no params for module %q; supported: %s", module, strings.Join(qp.chain.paramsModules(), ", ")),
+		}
+	}
+
+	params, err := getParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.ProtoMarshalJSON(params, nil)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "marshal %s params", module)
+	}
+
+	return &bindings.ModuleParamsResponse{Module: module, Params: bz}, nil
+}
+
+// GetInterchainAccount returns the interchain account of an owner on a
+// connection.
+func (qp QueryPlugin) GetInterchainAccount(ctx sdk.Context, owner, connectionID string) (*bindings.InterchainAccountResponse, error) {
+	if qp.chain.ICAAuth == nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "interchain accounts are not available"}
+	}
+
+	if _, err := parseAddress(owner); err != nil {
+		return nil, err
+	}
+
+	address, portID, channelID, err := qp.chain.ICAAuth.InterchainAccount(ctx, owner, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.InterchainAccountResponse{Address: address, PortID: portID, ChannelID: channelID}, nil
+}
+
+// rateLimitToWasm converts a rate limit to its contract representation.
+func rateLimitToWasm(rateLimit ratelimittypes.RateLimit) bindings.RateLimit {
+	var parsed bindings.RateLimit
+	if rateLimit.Path != nil {
+		parsed.Denom = rateLimit.Path.Denom
+		parsed.ChannelID = rateLimit.Path.ChannelId
+	}
+	if rateLimit.Quota != nil {
+		parsed.MaxPercentSend = rateLimit.Quota.MaxPercentSend.String()
+		parsed.MaxPercentRecv = rateLimit.Quota.MaxPercentRecv.String()
+		parsed.DurationHours = rateLimit.Quota.DurationHours
+	}
+	if rateLimit.Flow != nil {
+		parsed.Inflow = rateLimit.Flow.Inflow.String()
+		parsed.Outflow = rateLimit.Flow.Outflow.String()
+		parsed.ChannelValue = rateLimit.Flow.ChannelValue.String()
+	}
+
+	return parsed
+}
+
+// dispatchChainMsg handles the chain message variants. It returns false if
+// msg is not a chain message.
+func (m *CustomMessenger) dispatchChainMsg(ctx sdk.Context, contractAddr sdk.AccAddress, msg json.RawMessage) (sdk.Msg, bool, error) {
+	var contractMsg bindings.ChainMsg
+	if err := json.Unmarshal(msg, &contractMsg); err != nil {
+		return nil, false, errorsmod.Wrap(err, "chain msg")
+	}
+
+	var (
+		resp sdk.Msg
+		err  error
+	)
+	switch {
+	case contractMsg.RegisterInterchainAccount != nil:
+		ctx.GasMeter().ConsumeGas(GasCostChainMsg, "wasm chain msg")
+		resp, err = PerformRegisterInterchainAccount(m.router, ctx, contractAddr, contractMsg.RegisterInterchainAccount)
+	default:
+		return nil, false, nil
+	}
+
+	return resp, true, err
+}
+
+// PerformRegisterInterchainAccount opens an interchain account owned by the
+// contract. It executes MsgRegisterInterchainAccount through router, so the
+// circuit breaker and the message filter apply to it.
+func PerformRegisterInterchainAccount(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, register *bindings.RegisterInterchainAccount) (*icaauthtypes.MsgRegisterInterchainAccountResponse, error) {
+	if register.ConnectionID == "" {
+		return nil, wasmvmtypes.InvalidRequest{Err: "empty connection id"}
+	}
+
+	sdkMsg := icaauthtypes.NewMsgRegisterInterchainAccount(contractAddr, register.ConnectionID, register.Version)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := handleMsg[*icaauthtypes.MsgRegisterInterchainAccountResponse](router, ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "registering interchain account")
+	}
+
+	return resp, nil
+}
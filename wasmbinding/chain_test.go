@@ -0,0 +1,343 @@
+package wasmbinding_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/outbe/outbe-node/wasmbinding"
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+	icaauthtypes "github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+type mockBank struct {
+	bankkeeper.Keeper
+	metadata map[string]banktypes.Metadata
+	supply   map[string]sdkmath.Int
+}
+
+func (b mockBank) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := b.metadata[denom]
+	return metadata, found
+}
+
+func (b mockBank) GetSupply(_ context.Context, denom string) sdk.Coin {
+	amount, found := b.supply[denom]
+	if !found {
+		amount = sdkmath.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+type mockRateLimits []ratelimittypes.RateLimit
+
+func (m mockRateLimits) GetRateLimit(_ sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool) {
+	for _, rateLimit := range m {
+		if rateLimit.Path.Denom == denom && rateLimit.Path.ChannelId == channelID {
+			return rateLimit, true
+		}
+	}
+	return ratelimittypes.RateLimit{}, false
+}
+
+func (m mockRateLimits) GetAllRateLimits(sdk.Context) []ratelimittypes.RateLimit {
+	return m
+}
+
+type mockCircuit map[string]bool
+
+func (m mockCircuit) IsAllowed(_ context.Context, msgURL string) (bool, error) {
+	return !m[msgURL], nil
+}
+
+type mockICAAuth struct {
+	registered map[string]string
+}
+
+func (m *mockICAAuth) InterchainAccount(_ sdk.Context, owner, connectionID string) (string, string, string, error) {
+	if m.registered[owner] != connectionID {
+		return "", "", "", wasmvmtypes.NoSuchContract{Addr: owner}
+	}
+	return "host-account", "icacontroller-" + owner, "channel-7", nil
+}
+
+func newChainContext() sdk.Context {
+	return sdk.Context{}.WithGasMeter(storetypes.NewGasMeter(1_000_000)).WithEventManager(sdk.NewEventManager())
+}
+
+func newRateLimit(denom, channelID string) ratelimittypes.RateLimit {
+	return ratelimittypes.RateLimit{
+		Path: &ratelimittypes.Path{Denom: denom, ChannelId: channelID},
+		Quota: &ratelimittypes.Quota{
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(20),
+			DurationHours:  24,
+		},
+		Flow: &ratelimittypes.Flow{
+			Inflow:       sdkmath.NewInt(1),
+			Outflow:      sdkmath.NewInt(2),
+			ChannelValue: sdkmath.NewInt(1000),
+		},
+	}
+}
+
+func query[T any](t *testing.T, querier func(sdk.Context, json.RawMessage) ([]byte, error), ctx sdk.Context, req bindings.ChainQuery) T {
+	t.Helper()
+
+	bz, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	res, err := querier(ctx, bz)
+	require.NoError(t, err)
+
+	var parsed T
+	require.NoError(t, json.Unmarshal(res, &parsed))
+	return parsed
+}
+
+func TestChainQueries(t *testing.T) {
+	bank := mockBank{
+		metadata: map[string]banktypes.Metadata{"uoutbe": {Base: "uoutbe", Display: "outbe", Symbol: "OUTBE"}},
+		supply:   map[string]sdkmath.Int{"uoutbe": sdkmath.NewInt(42)},
+	}
+	chain := wasmbinding.ChainKeepers{
+		RateLimit: mockRateLimits{newRateLimit("uoutbe", "channel-0"), newRateLimit("uatom", "channel-1")},
+		Circuit:   mockCircuit{"/cosmos.bank.v1beta1.MsgSend": true},
+		ICAAuth:   &mockICAAuth{registered: map[string]string{}},
+		Params: map[string]wasmbinding.ParamsQuerier{
+			icaauthtypes.ModuleName: wasmbinding.ParamsOf(func(context.Context) icaauthtypes.Params {
+				return icaauthtypes.Params{AllowContracts: true}
+			}),
+		},
+	}
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(bank, nil, chain))
+
+	t.Run("version", func(t *testing.T) {
+		res := query[bindings.VersionResponse](t, querier, newChainContext(), bindings.ChainQuery{Version: &bindings.GetVersion{}})
+		require.Equal(t, bindings.Version, res.Version)
+		require.Equal(t, []string{icaauthtypes.ModuleName}, res.Modules)
+	})
+
+	t.Run("denom metadata", func(t *testing.T) {
+		res := query[bindings.DenomMetadataResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			DenomMetadata: &bindings.DenomMetadata{Denom: "uoutbe"},
+		})
+		require.NotNil(t, res.Metadata)
+		require.Equal(t, "OUTBE", res.Metadata.Symbol)
+		require.Equal(t, wasmvmtypes.Coin{Denom: "uoutbe", Amount: "42"}, res.Supply)
+
+		res = query[bindings.DenomMetadataResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			DenomMetadata: &bindings.DenomMetadata{Denom: "unknown"},
+		})
+		require.Nil(t, res.Metadata)
+		require.Equal(t, "0", res.Supply.Amount)
+	})
+
+	t.Run("rate limits", func(t *testing.T) {
+		res := query[bindings.RateLimitResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			RateLimit: &bindings.GetRateLimit{Denom: "uoutbe", ChannelID: "channel-0"},
+		})
+		require.Equal(t, &bindings.RateLimit{
+			Denom:          "uoutbe",
+			ChannelID:      "channel-0",
+			MaxPercentSend: "10",
+			MaxPercentRecv: "20",
+			DurationHours:  24,
+			Inflow:         "1",
+			Outflow:        "2",
+			ChannelValue:   "1000",
+		}, res.RateLimit)
+
+		res = query[bindings.RateLimitResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			RateLimit: &bindings.GetRateLimit{Denom: "uoutbe", ChannelID: "channel-1"},
+		})
+		require.Nil(t, res.RateLimit)
+
+		list := query[bindings.RateLimitsResponse](t, querier, newChainContext(), bindings.ChainQuery{RateLimits: &bindings.GetRateLimits{}})
+		require.Len(t, list.RateLimits, 2)
+
+		list = query[bindings.RateLimitsResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			RateLimits: &bindings.GetRateLimits{Denom: "uatom"},
+		})
+		require.Len(t, list.RateLimits, 1)
+		require.Equal(t, "channel-1", list.RateLimits[0].ChannelID)
+	})
+
+	t.Run("circuit breaker status", func(t *testing.T) {
+		res := query[bindings.CircuitBreakerStatusResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			CircuitBreakerStatus: &bindings.CircuitBreakerStatus{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"},
+		})
+		require.False(t, res.Allowed)
+
+		res = query[bindings.CircuitBreakerStatusResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			CircuitBreakerStatus: &bindings.CircuitBreakerStatus{MsgTypeURL: "/cosmos.bank.v1beta1.MsgMultiSend"},
+		})
+		require.True(t, res.Allowed)
+	})
+
+	t.Run("module params", func(t *testing.T) {
+		res := query[bindings.ModuleParamsResponse](t, querier, newChainContext(), bindings.ChainQuery{
+			ModuleParams: &bindings.ModuleParams{Module: icaauthtypes.ModuleName},
+		})
+		require.Equal(t, icaauthtypes.ModuleName, res.Module)
+		require.Contains(t, string(res.Params), `"allow_contracts":true`)
+
+		_, err := querier(newChainContext(), []byte(`{"module_params":{"module":"bank"}}`))
+		require.ErrorContains(t, err, "supported: "+icaauthtypes.ModuleName)
+	})
+
+	t.Run("gas", func(t *testing.T) {
+		ctx := newChainContext()
+		query[bindings.RateLimitsResponse](t, querier, ctx, bindings.ChainQuery{RateLimits: &bindings.GetRateLimits{}})
+		require.Equal(t, wasmbinding.GasCostChainQuery+2*wasmbinding.GasCostChainQueryItem, ctx.GasMeter().GasConsumed())
+	})
+
+	t.Run("unsupported keepers", func(t *testing.T) {
+		querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(bank, nil, wasmbinding.ChainKeepers{}))
+		_, err := querier(newChainContext(), []byte(`{"rate_limits":{}}`))
+		require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	})
+}
+
+func TestRegisterInterchainAccountMsg(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract_address____"))
+	registerURL := sdk.MsgTypeURL(&icaauthtypes.MsgRegisterInterchainAccount{})
+	router := &mockRouter{
+		responses: map[string]proto.Message{
+			registerURL: &icaauthtypes.MsgRegisterInterchainAccountResponse{PortId: "icacontroller-" + contract.String()},
+		},
+		blocked: map[string]bool{},
+	}
+	chain := wasmbinding.ChainKeepers{ICAAuth: &mockICAAuth{registered: map[string]string{contract.String(): "connection-0"}}}
+
+	messenger := wasmbinding.CustomMessageDecorator(router, chain)(nil)
+	dispatch := func(ctx sdk.Context, msg bindings.ChainMsg) ([][]byte, error) {
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+
+		_, data, _, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+		return data, err
+	}
+
+	ctx := newChainContext()
+	data, err := dispatch(ctx, bindings.ChainMsg{
+		RegisterInterchainAccount: &bindings.RegisterInterchainAccount{ConnectionID: "connection-0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{icaauthtypes.NewMsgRegisterInterchainAccount(contract, "connection-0", "")}, router.routed)
+	require.Equal(t, wasmbinding.GasCostChainMsg, ctx.GasMeter().GasConsumed())
+
+	var resp icaauthtypes.MsgRegisterInterchainAccountResponse
+	require.NoError(t, resp.Unmarshal(data[0]))
+	require.Equal(t, "icacontroller-"+contract.String(), resp.PortId)
+
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, nil, chain))
+	account := query[bindings.InterchainAccountResponse](t, querier, newChainContext(), bindings.ChainQuery{
+		InterchainAccount: &bindings.InterchainAccount{Owner: contract.String(), ConnectionID: "connection-0"},
+	})
+	require.Equal(t, "channel-7", account.ChannelID)
+
+	_, err = dispatch(newChainContext(), bindings.ChainMsg{RegisterInterchainAccount: &bindings.RegisterInterchainAccount{}})
+	require.ErrorAs(t, err, &wasmvmtypes.InvalidRequest{})
+
+	// the circuit breaker and the message filter reject the message in the
+	// router
+	router.blocked[registerURL] = true
+	_, err = dispatch(newChainContext(), bindings.ChainMsg{
+		RegisterInterchainAccount: &bindings.RegisterInterchainAccount{ConnectionID: "connection-1"},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, router.routed, 1)
+}
+
+// TestChainSchema checks that the published schema matches the bindings, so
+// that contracts built against it keep decoding the chain responses.
+func TestChainSchema(t *testing.T) {
+	responses := map[string]reflect.Type{
+		"version":                reflect.TypeOf(bindings.VersionResponse{}),
+		"denom_metadata":         reflect.TypeOf(bindings.DenomMetadataResponse{}),
+		"rate_limit":             reflect.TypeOf(bindings.RateLimitResponse{}),
+		"rate_limits":            reflect.TypeOf(bindings.RateLimitsResponse{}),
+		"circuit_breaker_status": reflect.TypeOf(bindings.CircuitBreakerStatusResponse{}),
+		"module_params":          reflect.TypeOf(bindings.ModuleParamsResponse{}),
+		"interchain_account":     reflect.TypeOf(bindings.InterchainAccountResponse{}),
+	}
+
+	for file, typ := range map[string]reflect.Type{
+		"chain_query.json": reflect.TypeOf(bindings.ChainQuery{}),
+		"chain_msg.json":   reflect.TypeOf(bindings.ChainMsg{}),
+	} {
+		t.Run(file, func(t *testing.T) {
+			bz, err := os.ReadFile(filepath.Join("schema", file))
+			require.NoError(t, err)
+
+			var schema struct {
+				Version string `json:"version"`
+				OneOf   []struct {
+					Required   []string                   `json:"required"`
+					Properties map[string]json.RawMessage `json:"properties"`
+				} `json:"oneOf"`
+				Responses map[string]json.RawMessage `json:"responses"`
+			}
+			require.NoError(t, json.Unmarshal(bz, &schema))
+			require.Equal(t, bindings.Version, schema.Version)
+			require.Len(t, schema.OneOf, typ.NumField())
+
+			for i, variant := range schema.OneOf {
+				field := typ.Field(i)
+				name := strings.Split(field.Tag.Get("json"), ",")[0]
+				require.Equal(t, []string{name}, variant.Required)
+				requireFields(t, field.Type.Elem(), variant.Properties[name])
+
+				if response, ok := schema.Responses[name]; ok {
+					requireFields(t, responses[name], response)
+				}
+			}
+		})
+	}
+}
+
+// requireFields checks the properties and required fields of an object
+// schema against the json tags of a struct.
+func requireFields(t *testing.T, typ reflect.Type, raw json.RawMessage) {
+	t.Helper()
+
+	var object struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &object))
+
+	var required []string
+	properties := make([]string, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		tag := strings.Split(typ.Field(i).Tag.Get("json"), ",")
+		properties = append(properties, tag[0])
+		if len(tag) == 1 {
+			required = append(required, tag[0])
+		}
+	}
+
+	require.ElementsMatch(t, required, object.Required, typ.Name())
+	require.Len(t, object.Properties, len(properties), typ.Name())
+	for _, property := range properties {
+		require.Contains(t, object.Properties, property, typ.Name())
+	}
+}
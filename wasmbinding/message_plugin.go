@@ -0,0 +1,322 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+)
+
+// CustomMessageDecorator returns a decorator that handles the custom chain and
+// tokenfactory messages and forwards everything else to the wrapped messenger.
+// The custom messages are converted to sdk messages executed through router,
+// so the message filter applies to them like to the other contract messages.
+func CustomMessageDecorator(router wasmkeeper.MessageRouter, chain ChainKeepers) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			router:  router,
+			chain:   chain,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	router  wasmkeeper.MessageRouter
+	chain   ChainKeepers
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes the contract message.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	if resp, ok, err := m.dispatchChainMsg(ctx, contractAddr, msg.Custom); err != nil {
+		return nil, nil, nil, err
+	} else if ok {
+		return encodeResponse(resp)
+	}
+
+	var contractMsg bindings.TokenFactoryMsg
+	if err := json.Unmarshal(msg.Custom, &contractMsg); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "token factory msg")
+	}
+
+	var (
+		resp sdk.Msg
+		err  error
+	)
+	switch {
+	case contractMsg.CreateDenom != nil:
+		resp, err = PerformCreateDenom(m.router, ctx, contractAddr, contractMsg.CreateDenom)
+	case contractMsg.MintTokens != nil:
+		resp, err = PerformMint(m.router, ctx, contractAddr, contractMsg.MintTokens)
+	case contractMsg.ChangeAdmin != nil:
+		resp, err = PerformChangeAdmin(m.router, ctx, contractAddr, contractMsg.ChangeAdmin)
+	case contractMsg.BurnTokens != nil:
+		resp, err = PerformBurn(m.router, ctx, contractAddr, contractMsg.BurnTokens)
+	case contractMsg.SetMetadata != nil:
+		resp, err = PerformSetMetadata(m.router, ctx, contractAddr, contractMsg.SetMetadata.Denom, contractMsg.SetMetadata.Metadata)
+	case contractMsg.ForceTransfer != nil:
+		resp, err = PerformForceTransfer(m.router, ctx, contractAddr, contractMsg.ForceTransfer)
+	default:
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return encodeResponse(resp)
+}
+
+// encodeResponse returns the message response both as raw data and as the
+// Any wrapped msg response expected by wasmd.
+func encodeResponse(resp sdk.Msg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	msgResponse, err := codectypes.NewAnyWithValue(resp)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nil, [][]byte{msgResponse.Value}, [][]*codectypes.Any{{msgResponse}}, nil
+}
+
+// handleMsg executes msg through router and returns its response. The events
+// of the message are emitted on ctx, as if its msg server was called directly.
+func handleMsg[T proto.Message](router wasmkeeper.MessageRouter, ctx sdk.Context, msg sdk.Msg) (T, error) {
+	var resp T
+
+	handler := router.Handler(msg)
+	if handler == nil {
+		return resp, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return resp, err
+	}
+
+	for _, event := range res.GetEvents() {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+
+	if len(res.MsgResponses) == 0 {
+		return resp, errorsmod.Wrapf(sdkerrors.ErrLogic, "no response to %s", sdk.MsgTypeURL(msg))
+	}
+
+	resp, ok := res.MsgResponses[0].GetCachedValue().(T)
+	if !ok {
+		return resp, errorsmod.Wrapf(sdkerrors.ErrLogic, "unexpected response %s to %s", res.MsgResponses[0].TypeUrl, sdk.MsgTypeURL(msg))
+	}
+
+	return resp, nil
+}
+
+// PerformCreateDenom creates a denom owned by the contract and optionally
+// sets its metadata.
+func PerformCreateDenom(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindings.CreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error) {
+	msgCreateDenom := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom)
+	if err := msgCreateDenom.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed validating MsgCreateDenom")
+	}
+
+	resp, err := handleMsg[*tokenfactorytypes.MsgCreateDenomResponse](router, ctx, msgCreateDenom)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "creating denom")
+	}
+
+	if createDenom.Metadata != nil {
+		if _, err := PerformSetMetadata(router, ctx, contractAddr, resp.NewTokenDenom, *createDenom.Metadata); err != nil {
+			return nil, errorsmod.Wrap(err, "setting metadata")
+		}
+	}
+
+	return resp, nil
+}
+
+// PerformMint mints a denom administered by the contract to an address.
+func PerformMint(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindings.MintTokens) (*tokenfactorytypes.MsgMintResponse, error) {
+	if _, err := parseAddress(mint.MintToAddress); err != nil {
+		return nil, err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgMintTo(contractAddr.String(), sdk.Coin{Denom: mint.Denom, Amount: mint.Amount}, mint.MintToAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := handleMsg[*tokenfactorytypes.MsgMintResponse](router, ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "minting coins from message")
+	}
+
+	return resp, nil
+}
+
+// PerformChangeAdmin changes the admin of a denom administered by the
+// contract.
+func PerformChangeAdmin(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) (*tokenfactorytypes.MsgChangeAdminResponse, error) {
+	if _, err := parseAddress(changeAdmin.NewAdminAddress); err != nil {
+		return nil, err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, changeAdmin.NewAdminAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := handleMsg[*tokenfactorytypes.MsgChangeAdminResponse](router, ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed changing admin from message")
+	}
+
+	return resp, nil
+}
+
+// PerformBurn burns a denom administered by the contract, from the contract
+// itself or from BurnFromAddress.
+func PerformBurn(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindings.BurnTokens) (*tokenfactorytypes.MsgBurnResponse, error) {
+	coin := sdk.Coin{Denom: burn.Denom, Amount: burn.Amount}
+	sdkMsg := tokenfactorytypes.NewMsgBurn(contractAddr.String(), coin)
+	if burn.BurnFromAddress != "" {
+		sdkMsg = tokenfactorytypes.NewMsgBurnFrom(contractAddr.String(), coin, burn.BurnFromAddress)
+	}
+
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := handleMsg[*tokenfactorytypes.MsgBurnResponse](router, ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "burning coins from message")
+	}
+
+	return resp, nil
+}
+
+// PerformForceTransfer moves a denom administered by the contract between two
+// addresses.
+func PerformForceTransfer(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindings.ForceTransfer) (*tokenfactorytypes.MsgForceTransferResponse, error) {
+	if _, err := parseAddress(forceTransfer.FromAddress); err != nil {
+		return nil, err
+	}
+
+	if _, err := parseAddress(forceTransfer.ToAddress); err != nil {
+		return nil, err
+	}
+
+	coin := sdk.Coin{Denom: forceTransfer.Denom, Amount: forceTransfer.Amount}
+	sdkMsg := tokenfactorytypes.NewMsgForceTransfer(contractAddr.String(), coin, forceTransfer.FromAddress, forceTransfer.ToAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	resp, err := handleMsg[*tokenfactorytypes.MsgForceTransferResponse](router, ctx, sdkMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "force transferring from message")
+	}
+
+	return resp, nil
+}
+
+// PerformSetMetadata sets the bank metadata of a denom administered by the
+// contract.
+func PerformSetMetadata(router wasmkeeper.MessageRouter, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindings.Metadata) (*tokenfactorytypes.MsgSetDenomMetadataResponse, error) {
+	// bank keys metadata by Base, so it must match the denom
+	if metadata.Base == "" {
+		metadata.Base = denom
+	} else if metadata.Base != denom {
+		return nil, wasmvmtypes.InvalidRequest{Err: "base must be the same as denom"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomMetadata(contractAddr.String(), WasmMetadataToSdk(metadata))
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return handleMsg[*tokenfactorytypes.MsgSetDenomMetadataResponse](router, ctx, sdkMsg)
+}
+
+// GetFullDenom returns the factory denom a creator gets for subDenom.
+func GetFullDenom(creator string, subDenom string) (string, error) {
+	if _, err := parseAddress(creator); err != nil {
+		return "", err
+	}
+
+	fullDenom, err := tokenfactorytypes.GetTokenDenom(creator, subDenom)
+	if err != nil {
+		return "", errorsmod.Wrap(err, "validate sub-denom")
+	}
+
+	return fullDenom, nil
+}
+
+// parseAddress parses address from bech32 string and verifies its format.
+func parseAddress(addr string) (sdk.AccAddress, error) {
+	parsed, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "address from bech32")
+	}
+
+	if err := sdk.VerifyAddressFormat(parsed); err != nil {
+		return nil, errorsmod.Wrap(err, "verify address format")
+	}
+
+	return parsed, nil
+}
+
+// WasmMetadataToSdk converts contract metadata to bank metadata.
+func WasmMetadataToSdk(metadata bindings.Metadata) banktypes.Metadata {
+	denoms := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		denoms = append(denoms, &banktypes.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: metadata.Description,
+		Display:     metadata.Display,
+		Base:        metadata.Base,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		DenomUnits:  denoms,
+	}
+}
+
+// SdkMetadataToWasm converts bank metadata to contract metadata.
+func SdkMetadataToWasm(metadata banktypes.Metadata) *bindings.Metadata {
+	denoms := make([]bindings.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		denoms = append(denoms, bindings.DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  unit.Aliases,
+		})
+	}
+
+	return &bindings.Metadata{
+		Description: metadata.Description,
+		Display:     metadata.Display,
+		Base:        metadata.Base,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		DenomUnits:  denoms,
+	}
+}
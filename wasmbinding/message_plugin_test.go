@@ -0,0 +1,109 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/outbe/outbe-node/wasmbinding"
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+)
+
+// mockRouter records the messages it routes and answers them with the
+// response of their type. Blocked type URLs are rejected, like the message
+// filter does.
+type mockRouter struct {
+	responses map[string]proto.Message
+	blocked   map[string]bool
+	routed    []sdk.Msg
+}
+
+func (r *mockRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	resp, found := r.responses[sdk.MsgTypeURL(msg)]
+	if !found {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if r.blocked[sdk.MsgTypeURL(msg)] {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is blocked", sdk.MsgTypeURL(msg))
+		}
+
+		r.routed = append(r.routed, msg)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		ctx.EventManager().EmitEvent(sdk.NewEvent("routed", sdk.NewAttribute("type", sdk.MsgTypeURL(msg))))
+		return sdk.WrapServiceResult(ctx, resp, nil)
+	}
+}
+
+func TestTokenFactoryMsgs(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract_address____"))
+	denom := "factory/" + contract.String() + "/token"
+	router := &mockRouter{
+		responses: map[string]proto.Message{
+			sdk.MsgTypeURL(&tokenfactorytypes.MsgCreateDenom{}):      &tokenfactorytypes.MsgCreateDenomResponse{NewTokenDenom: denom},
+			sdk.MsgTypeURL(&tokenfactorytypes.MsgSetDenomMetadata{}): &tokenfactorytypes.MsgSetDenomMetadataResponse{},
+			sdk.MsgTypeURL(&tokenfactorytypes.MsgMint{}):             &tokenfactorytypes.MsgMintResponse{},
+		},
+		blocked: map[string]bool{},
+	}
+
+	messenger := wasmbinding.CustomMessageDecorator(router, wasmbinding.ChainKeepers{})(nil)
+	dispatch := func(ctx sdk.Context, msg bindings.TokenFactoryMsg) ([][]byte, error) {
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+
+		_, data, _, err := messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+		return data, err
+	}
+
+	// the metadata of a new denom is set by a second message
+	ctx := newChainContext()
+	data, err := dispatch(ctx, bindings.TokenFactoryMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "token",
+		Metadata: &bindings.Metadata{
+			Name:       "Token",
+			Symbol:     "TOKEN",
+			Display:    denom,
+			DenomUnits: []bindings.DenomUnit{{Denom: denom}},
+		},
+	}})
+	require.NoError(t, err)
+	var resp tokenfactorytypes.MsgCreateDenomResponse
+	require.NoError(t, resp.Unmarshal(data[0]))
+	require.Equal(t, denom, resp.NewTokenDenom)
+
+	require.Len(t, router.routed, 2)
+	setMetadata, ok := router.routed[1].(*tokenfactorytypes.MsgSetDenomMetadata)
+	require.True(t, ok)
+	require.Equal(t, contract.String(), setMetadata.Sender)
+	require.Equal(t, denom, setMetadata.Metadata.Base)
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	// messages the router rejects fail the dispatch
+	router.blocked[sdk.MsgTypeURL(&tokenfactorytypes.MsgMint{})] = true
+	_, err = dispatch(newChainContext(), bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{
+		Denom:         denom,
+		Amount:        sdkmath.NewInt(10),
+		MintToAddress: contract.String(),
+	}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, router.routed, 2)
+
+	// messages without a route are not executed
+	_, err = dispatch(newChainContext(), bindings.TokenFactoryMsg{ChangeAdmin: &bindings.ChangeAdmin{
+		Denom:           denom,
+		NewAdminAddress: contract.String(),
+	}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
@@ -0,0 +1,121 @@
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	tokenfactorykeeper "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/keeper"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+)
+
+type QueryPlugin struct {
+	bankKeeper         bankkeeper.Keeper
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	chain              ChainKeepers
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(b bankkeeper.Keeper, tfk *tokenfactorykeeper.Keeper, chain ChainKeepers) *QueryPlugin {
+	return &QueryPlugin{
+		bankKeeper:         b,
+		tokenFactoryKeeper: tfk,
+		chain:              chain,
+	}
+}
+
+// CustomQuerier dispatches the custom chain and tokenfactory queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		if bz, ok, err := qp.chainQuery(ctx, request); ok || err != nil {
+			return bz, err
+		}
+
+		var contractQuery bindings.TokenFactoryQuery
+		if err := json.Unmarshal(request, &contractQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "token factory query")
+		}
+
+		var (
+			res any
+			err error
+		)
+		switch {
+		case contractQuery.FullDenom != nil:
+			var denom string
+			denom, err = GetFullDenom(contractQuery.FullDenom.CreatorAddr, contractQuery.FullDenom.Subdenom)
+			res = bindings.FullDenomResponse{Denom: denom}
+		case contractQuery.Admin != nil:
+			res, err = qp.GetDenomAdmin(ctx, contractQuery.Admin.Denom)
+		case contractQuery.Metadata != nil:
+			res, err = qp.GetMetadata(ctx, contractQuery.Metadata.Denom)
+		case contractQuery.DenomsByCreator != nil:
+			res, err = qp.GetDenomsByCreator(ctx, contractQuery.DenomsByCreator.Creator)
+		case contractQuery.Params != nil:
+			res, err = qp.GetParams(ctx)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown custom query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal token factory response: %w", err)
+		}
+
+		return bz, nil
+	}
+}
+
+// GetDenomAdmin returns the admin of a factory denom.
+func (qp QueryPlugin) GetDenomAdmin(ctx context.Context, denom string) (*bindings.AdminResponse, error) {
+	metadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin for denom: %s", denom)
+	}
+
+	return &bindings.AdminResponse{Admin: metadata.Admin}, nil
+}
+
+// GetDenomsByCreator returns the factory denoms created by an address.
+func (qp QueryPlugin) GetDenomsByCreator(ctx context.Context, creator string) (*bindings.DenomsByCreatorResponse, error) {
+	if _, err := parseAddress(creator); err != nil {
+		return nil, err
+	}
+
+	denoms := qp.tokenFactoryKeeper.GetDenomsFromCreator(sdk.UnwrapSDKContext(ctx), creator)
+	return &bindings.DenomsByCreatorResponse{Denoms: denoms}, nil
+}
+
+// GetMetadata returns the bank metadata of a denom, if any.
+func (qp QueryPlugin) GetMetadata(ctx context.Context, denom string) (*bindings.MetadataResponse, error) {
+	metadata, found := qp.bankKeeper.GetDenomMetaData(ctx, denom)
+
+	var parsed *bindings.Metadata
+	if found {
+		parsed = SdkMetadataToWasm(metadata)
+	}
+
+	return &bindings.MetadataResponse{Metadata: parsed}, nil
+}
+
+// GetParams returns the tokenfactory params.
+func (qp QueryPlugin) GetParams(ctx context.Context) (*bindings.ParamsResponse, error) {
+	params := qp.tokenFactoryKeeper.GetParams(sdk.UnwrapSDKContext(ctx))
+
+	fee := make([]wasmvmtypes.Coin, 0, len(params.DenomCreationFee))
+	for _, c := range params.DenomCreationFee {
+		fee = append(fee, wasmvmtypes.Coin{Denom: c.Denom, Amount: c.Amount.String()})
+	}
+
+	return &bindings.ParamsResponse{Params: bindings.Params{DenomCreationFee: fee}}, nil
+}
@@ -0,0 +1,37 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/wasmbinding"
+	"github.com/outbe/outbe-node/wasmbinding/bindings"
+)
+
+func TestFullDenomQuery(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_address_____")).String()
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(nil, nil, wasmbinding.ChainKeepers{}))
+
+	req, err := json.Marshal(bindings.TokenFactoryQuery{
+		FullDenom: &bindings.FullDenom{CreatorAddr: creator, Subdenom: "token"},
+	})
+	require.NoError(t, err)
+
+	bz, err := querier(sdk.Context{}, req)
+	require.NoError(t, err)
+
+	var res bindings.FullDenomResponse
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, "factory/"+creator+"/token", res.Denom)
+
+	_, err = querier(sdk.Context{}, []byte(`{"full_denom":{"creator_addr":"invalid","subdenom":"token"}}`))
+	require.Error(t, err)
+
+	_, err = querier(sdk.Context{}, []byte(`{}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
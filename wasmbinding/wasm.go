@@ -0,0 +1,30 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	tokenfactorykeeper "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/keeper"
+
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options that expose the
+// tokenfactory and the chain native modules to contracts through custom
+// messages and queries. Custom messages are executed through router.
+func RegisterCustomPlugins(
+	router wasmkeeper.MessageRouter,
+	bank bankkeeper.Keeper,
+	tokenFactory *tokenfactorykeeper.Keeper,
+	chain ChainKeepers,
+) []wasmkeeper.Option {
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(NewQueryPlugin(bank, tokenFactory, chain)),
+	})
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(router, chain),
+	)
+
+	return []wasmkeeper.Option{
+		queryPluginOpt,
+		messengerDecoratorOpt,
+	}
+}
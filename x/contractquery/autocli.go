@@ -0,0 +1,41 @@
+package contractquery
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "contractquery.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the gRPC queries contracts can send",
+				},
+				{
+					RpcMethod:      "AcceptedQuery",
+					Use:            "accepted-query [path]",
+					Short:          "Query whether contracts can send a gRPC query",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "path"}},
+				},
+				{
+					RpcMethod: "Capabilities",
+					Use:       "capabilities",
+					Short:     "Query the capabilities contracts can require",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "contractquery.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/contractquery/types"
+)
+
+type Keeper struct {
+	cdc codec.Codec
+
+	logger log.Logger
+
+	// state management
+	Schema          collections.Schema
+	AcceptedQueries collections.Map[string, string]
+
+	queryRouter  types.QueryRouter
+	capabilities []string
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	queryRouter types.QueryRouter,
+	capabilities []string,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		AcceptedQueries: collections.NewMap(sb, types.AcceptedQueriesKey, "accepted_queries", collections.StringKey, collections.StringValue),
+
+		queryRouter:  queryRouter,
+		capabilities: capabilities,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, sorted by path.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	params := types.NewParams([]types.AcceptedQuery{})
+
+	err := k.AcceptedQueries.Walk(ctx, nil, func(path, responseType string) (bool, error) {
+		params.AcceptedQueries = append(params.AcceptedQueries, types.NewAcceptedQuery(path, responseType))
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return params
+}
+
+// SetParams validates and stores the module params. Every accepted query
+// must be routed by the query router.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	for _, q := range p.AcceptedQueries {
+		if k.queryRouter.Route(q.Path) == nil {
+			return errorsmod.Wrapf(types.ErrUnknownQuery, "no handler for %s", q.Path)
+		}
+	}
+
+	if err := k.AcceptedQueries.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, q := range p.AcceptedQueries {
+		if err := k.AcceptedQueries.Set(ctx, q.Path, q.ResponseType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Capabilities returns the capabilities of the wasm VMs.
+func (k Keeper) Capabilities() []string {
+	return k.capabilities
+}
+
+// AcceptedResponse returns an empty response of an accepted query.
+func (k Keeper) AcceptedResponse(ctx context.Context, path string) (proto.Message, error) {
+	responseType, err := k.AcceptedQueries.Get(ctx, path)
+	if err != nil {
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", path)}
+	}
+
+	return types.NewAcceptedQuery(path, responseType).NewResponse()
+}
+
+// StargateQuerier returns the wasm stargate query plugin answering the
+// accepted queries with their JSON encoded response.
+func (k Keeper) StargateQuerier() func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		response, err := k.AcceptedResponse(ctx, request.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		acceptList := wasmkeeper.AcceptedQueries{request.Path: response}
+		return wasmkeeper.AcceptListStargateQuerier(acceptList, k.queryRouter, k.cdc)(ctx, request)
+	}
+}
+
+// GrpcQuerier returns the wasm gRPC query plugin answering the accepted
+// queries with their proto response.
+func (k Keeper) GrpcQuerier() func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+		response, err := k.AcceptedResponse(ctx, request.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		acceptList := wasmkeeper.AcceptedQueries{request.Path: response}
+		return wasmkeeper.AcceptListGrpcQuerier(acceptList, k.queryRouter, k.cdc)(ctx, request)
+	}
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
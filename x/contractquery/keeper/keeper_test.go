@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/contractquery/keeper"
+	"github.com/outbe/outbe-node/x/contractquery/types"
+)
+
+const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+var (
+	balanceQuery    = types.NewAcceptedQuery(balancePath, "cosmos.bank.v1beta1.QueryBalanceResponse")
+	authParamsQuery = types.NewAcceptedQuery("/cosmos.auth.v1beta1.Query/Params", "cosmos.auth.v1beta1.QueryParamsResponse")
+)
+
+// mockRouter routes every path of routes to a handler answering a balance.
+type mockRouter struct {
+	routes map[string]bool
+}
+
+func (m mockRouter) Route(path string) baseapp.GRPCQueryHandler {
+	if !m.routes[path] {
+		return nil
+	}
+
+	return func(sdk.Context, *abci.RequestQuery) (*abci.ResponseQuery, error) {
+		coin := sdk.NewCoin("uoutbe", sdkmath.NewInt(42))
+		bz, err := (&banktypes.QueryBalanceResponse{Balance: &coin}).Marshal()
+		return &abci.ResponseQuery{Value: bz}, err
+	}
+}
+
+type fixture struct {
+	ctx    sdk.Context
+	k      keeper.Keeper
+	router mockRouter
+}
+
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	f := fixture{
+		ctx:    testCtx.Ctx,
+		router: mockRouter{routes: map[string]bool{}},
+	}
+	f.router.routes[balanceQuery.Path] = true
+	f.router.routes[authParamsQuery.Path] = true
+
+	f.k = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		f.router,
+		[]string{"iterator", "staking"},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	genesis := types.NewGenesisState(types.NewParams([]types.AcceptedQuery{balanceQuery, authParamsQuery}))
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesis))
+
+	return f
+}
+
+func TestParams(t *testing.T) {
+	f := setupKeeper(t)
+
+	// sorted by path
+	require.Equal(t, []types.AcceptedQuery{authParamsQuery, balanceQuery}, f.k.GetParams(f.ctx).AcceptedQueries)
+
+	for name, tc := range map[string]struct {
+		query types.AcceptedQuery
+		err   error
+	}{
+		"malformed path": {
+			query: types.NewAcceptedQuery("cosmos.bank.v1beta1.Query/Balance", balanceQuery.ResponseType),
+			err:   types.ErrInvalidParams,
+		},
+		"tx service": {
+			query: types.NewAcceptedQuery("/cosmos.tx.v1beta1.Service/Simulate", "cosmos.tx.v1beta1.SimulateResponse"),
+			err:   types.ErrNonDeterministicQuery,
+		},
+		"node config": {
+			query: types.NewAcceptedQuery("/cosmos.autocli.v1.Query/AppOptions", "cosmos.autocli.v1.AppOptionsResponse"),
+			err:   types.ErrNonDeterministicQuery,
+		},
+		"unknown method": {
+			query: types.NewAcceptedQuery("/cosmos.bank.v1beta1.Query/Unknown", balanceQuery.ResponseType),
+			err:   types.ErrUnknownQuery,
+		},
+		"wrong response type": {
+			query: types.NewAcceptedQuery(balancePath, "cosmos.bank.v1beta1.QueryAllBalancesResponse"),
+			err:   types.ErrInvalidParams,
+		},
+		"not routed": {
+			query: types.NewAcceptedQuery("/cosmos.bank.v1beta1.Query/AllBalances", "cosmos.bank.v1beta1.QueryAllBalancesResponse"),
+			err:   types.ErrUnknownQuery,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := f.k.SetParams(f.ctx, types.NewParams([]types.AcceptedQuery{tc.query}))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	duplicate := types.NewParams([]types.AcceptedQuery{balanceQuery, balanceQuery})
+	require.ErrorIs(t, f.k.SetParams(f.ctx, duplicate), types.ErrInvalidParams)
+
+	// failed updates keep the accept-list
+	require.Len(t, f.k.GetParams(f.ctx).AcceptedQueries, 2)
+
+	require.NoError(t, f.k.SetParams(f.ctx, types.NewParams([]types.AcceptedQuery{balanceQuery})))
+	require.Equal(t, []types.AcceptedQuery{balanceQuery}, f.k.GetParams(f.ctx).AcceptedQueries)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	params := types.NewParams([]types.AcceptedQuery{balanceQuery})
+
+	_, err := ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	res, err := keeper.NewQuerier(f.k).AcceptedQuery(f.ctx, &types.QueryAcceptedQueryRequest{Path: balancePath})
+	require.NoError(t, err)
+	require.True(t, res.Accepted)
+	require.Equal(t, balanceQuery.ResponseType, res.ResponseType)
+
+	res, err = keeper.NewQuerier(f.k).AcceptedQuery(f.ctx, &types.QueryAcceptedQueryRequest{Path: authParamsQuery.Path})
+	require.NoError(t, err)
+	require.False(t, res.Accepted)
+}
+
+func TestQueriers(t *testing.T) {
+	f := setupKeeper(t)
+	stargate := f.k.StargateQuerier()
+	grpc := f.k.GrpcQuerier()
+
+	bz, err := stargate(f.ctx, &wasmvmtypes.StargateQuery{Path: balancePath})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance":{"denom":"uoutbe","amount":"42"}}`, string(bz))
+
+	res, err := grpc(f.ctx, &wasmvmtypes.GrpcQuery{Path: balancePath})
+	require.NoError(t, err)
+	require.Equal(t, "42", res.(*banktypes.QueryBalanceResponse).Balance.Amount.String())
+
+	// routed, but not accepted
+	f.router.routes["/cosmos.bank.v1beta1.Query/AllBalances"] = true
+	_, err = stargate(f.ctx, &wasmvmtypes.StargateQuery{Path: "/cosmos.bank.v1beta1.Query/AllBalances"})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	_, err = grpc(f.ctx, &wasmvmtypes.GrpcQuery{Path: "/cosmos.bank.v1beta1.Query/AllBalances"})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
+
+func TestCapabilities(t *testing.T) {
+	f := setupKeeper(t)
+
+	res, err := keeper.NewQuerier(f.k).Capabilities(f.ctx, &types.QueryCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"iterator", "staking"}, res.Capabilities)
+	require.NotEmpty(t, res.LibwasmvmVersion)
+}
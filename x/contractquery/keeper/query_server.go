@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+
+	"github.com/outbe/outbe-node/x/contractquery/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// AcceptedQuery returns whether contracts can send a query.
+func (k Querier) AcceptedQuery(c context.Context, req *types.QueryAcceptedQueryRequest) (*types.QueryAcceptedQueryResponse, error) {
+	responseType, err := k.AcceptedQueries.Get(c, req.Path)
+	if err != nil {
+		return &types.QueryAcceptedQueryResponse{}, nil
+	}
+
+	return &types.QueryAcceptedQueryResponse{ResponseType: responseType, Accepted: true}, nil
+}
+
+// Capabilities returns the capabilities of the wasm VMs and the version of
+// the library running them.
+func (k Querier) Capabilities(_ context.Context, _ *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	version, err := wasmvm.LibwasmvmVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCapabilitiesResponse{Capabilities: k.Keeper.Capabilities(), LibwasmvmVersion: version}, nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams         = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrNonDeterministicQuery = errorsmod.Register(ModuleName, 2, "non-deterministic query")
+	ErrUnknownQuery          = errorsmod.Register(ModuleName, 3, "query is not registered")
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// QueryRouter routes the accepted queries to their gRPC handlers.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
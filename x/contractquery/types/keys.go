@@ -0,0 +1,19 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// AcceptedQueriesKey saves the response type of the accepted queries by
+	// path.
+	AcceptedQueriesKey = collections.NewPrefix(0)
+)
+
+const (
+	ModuleName = "contractquery"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// nonDeterministicServices are Query services whose answers depend on the
+// configuration of the node serving them rather than on the chain state.
+var nonDeterministicServices = map[string]bool{
+	"cosmos.app.v1alpha1.Query": true,
+	"cosmos.autocli.v1.Query":   true,
+}
+
+// DefaultAcceptedQueries are the queries contracts can send on a new chain.
+// All of them are answered from the module stores.
+var DefaultAcceptedQueries = []AcceptedQuery{
+	NewAcceptedQuery("/cosmos.auth.v1beta1.Query/Account", "cosmos.auth.v1beta1.QueryAccountResponse"),
+	NewAcceptedQuery("/cosmos.auth.v1beta1.Query/Params", "cosmos.auth.v1beta1.QueryParamsResponse"),
+	NewAcceptedQuery("/cosmos.bank.v1beta1.Query/Balance", "cosmos.bank.v1beta1.QueryBalanceResponse"),
+	NewAcceptedQuery("/cosmos.bank.v1beta1.Query/DenomMetadata", "cosmos.bank.v1beta1.QueryDenomMetadataResponse"),
+	NewAcceptedQuery("/cosmos.bank.v1beta1.Query/Params", "cosmos.bank.v1beta1.QueryParamsResponse"),
+	NewAcceptedQuery("/cosmos.bank.v1beta1.Query/SupplyOf", "cosmos.bank.v1beta1.QuerySupplyOfResponse"),
+	NewAcceptedQuery("/cosmos.distribution.v1beta1.Query/DelegationRewards", "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse"),
+	NewAcceptedQuery("/cosmos.distribution.v1beta1.Query/Params", "cosmos.distribution.v1beta1.QueryParamsResponse"),
+	NewAcceptedQuery("/cosmos.staking.v1beta1.Query/Delegation", "cosmos.staking.v1beta1.QueryDelegationResponse"),
+	NewAcceptedQuery("/cosmos.staking.v1beta1.Query/Params", "cosmos.staking.v1beta1.QueryParamsResponse"),
+	NewAcceptedQuery("/cosmos.staking.v1beta1.Query/Validator", "cosmos.staking.v1beta1.QueryValidatorResponse"),
+	NewAcceptedQuery("/ibc.applications.transfer.v1.Query/DenomTrace", "ibc.applications.transfer.v1.QueryDenomTraceResponse"),
+	NewAcceptedQuery("/ibc.core.channel.v1.Query/Channel", "ibc.core.channel.v1.QueryChannelResponse"),
+	NewAcceptedQuery("/ibc.core.client.v1.Query/ClientState", "ibc.core.client.v1.QueryClientStateResponse"),
+	NewAcceptedQuery("/ibc.core.client.v1.Query/ConsensusState", "ibc.core.client.v1.QueryConsensusStateResponse"),
+	NewAcceptedQuery("/ibc.core.connection.v1.Query/Connection", "ibc.core.connection.v1.QueryConnectionResponse"),
+	NewAcceptedQuery("/osmosis.tokenfactory.v1beta1.Query/DenomAuthorityMetadata", "osmosis.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse"),
+	NewAcceptedQuery("/osmosis.tokenfactory.v1beta1.Query/DenomsFromCreator", "osmosis.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse"),
+	NewAcceptedQuery("/osmosis.tokenfactory.v1beta1.Query/Params", "osmosis.tokenfactory.v1beta1.QueryParamsResponse"),
+	NewAcceptedQuery("/ratelimit.v1.Query/RateLimit", "ratelimit.v1.QueryRateLimitResponse"),
+}
+
+// DefaultParams returns default module parameters, accepting
+// DefaultAcceptedQueries.
+func DefaultParams() Params {
+	return NewParams(append([]AcceptedQuery{}, DefaultAcceptedQueries...))
+}
+
+// NewParams creates a new Params instance.
+func NewParams(acceptedQueries []AcceptedQuery) Params {
+	return Params{
+		AcceptedQueries: acceptedQueries,
+	}
+}
+
+// NewAcceptedQuery creates a new AcceptedQuery instance.
+func NewAcceptedQuery(path, responseType string) AcceptedQuery {
+	return AcceptedQuery{
+		Path:         path,
+		ResponseType: responseType,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.AcceptedQueries))
+	for _, q := range p.AcceptedQueries {
+		if seen[q.Path] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate query %s", q.Path)
+		}
+		seen[q.Path] = true
+
+		if err := q.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the path is a deterministic gRPC query method and that
+// the response type is the registered response of the method.
+func (q AcceptedQuery) Validate() error {
+	service, method, ok := strings.Cut(strings.TrimPrefix(q.Path, "/"), "/")
+	if !strings.HasPrefix(q.Path, "/") || !ok || service == "" || method == "" || strings.Contains(method, "/") {
+		return errorsmod.Wrapf(ErrInvalidParams, "query path %q is not of the form /<service>/<method>", q.Path)
+	}
+
+	// tx, node and reflection services are not answered from state
+	if nonDeterministicServices[service] || !strings.HasSuffix(service, ".Query") {
+		return errorsmod.Wrapf(ErrNonDeterministicQuery, "%s", q.Path)
+	}
+
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return errorsmod.Wrapf(ErrUnknownQuery, "%s: %s", q.Path, err)
+	}
+
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return errorsmod.Wrapf(ErrUnknownQuery, "%s is not a service", service)
+	}
+
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return errorsmod.Wrapf(ErrUnknownQuery, "%s", q.Path)
+	}
+
+	if methodDesc.IsStreamingClient() || methodDesc.IsStreamingServer() {
+		return errorsmod.Wrapf(ErrNonDeterministicQuery, "%s is a streaming method", q.Path)
+	}
+
+	if output := string(methodDesc.Output().FullName()); output != q.ResponseType {
+		return errorsmod.Wrapf(ErrInvalidParams, "%s responds with %s, not %s", q.Path, output, q.ResponseType)
+	}
+
+	if _, err := q.NewResponse(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewResponse returns an empty response of the query.
+func (q AcceptedQuery) NewResponse() (proto.Message, error) {
+	typ := proto.MessageType(q.ResponseType)
+	if typ == nil {
+		return nil, errorsmod.Wrapf(ErrInvalidParams, "response type %s is not registered", q.ResponseType)
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	msg, ok := reflect.New(typ).Interface().(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidParams, "response type %s is not a proto message", q.ResponseType)
+	}
+
+	return msg, nil
+}
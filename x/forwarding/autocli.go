@@ -0,0 +1,36 @@
+package forwarding
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "forwarding.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the packet-forward params and the config of the node",
+				},
+				{
+					RpcMethod: "InFlightPackets",
+					Use:       "in-flight-packets",
+					Short:     "Query the forwarded packets not acknowledged yet",
+					Long:      "Query the forwarded packets not acknowledged yet, with the packet they were received with and the fee held for them, optionally only the ones forwarded on --channel-id",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "forwarding.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+package forwarding
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/outbe/outbe-node/x/forwarding/keeper"
+)
+
+var _ porttypes.Middleware = IBCMiddleware{}
+
+// IBCMiddleware is the packet-forward middleware configured from the params
+// of the module, and charging the forward fee. The packet-forward middleware
+// takes its retries and timeout when it is built, so it is built again from
+// the current params for every packet.
+type IBCMiddleware struct {
+	app                 porttypes.IBCModule
+	packetForwardKeeper *packetforwardkeeper.Keeper
+	keeper              keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keepers and the
+// module the packet-forward middleware wraps.
+func NewIBCMiddleware(app porttypes.IBCModule, pfk *packetforwardkeeper.Keeper, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{app: app, packetForwardKeeper: pfk, keeper: k}
+}
+
+// packetForward returns the packet-forward middleware with the current
+// params.
+func (im IBCMiddleware) packetForward(ctx sdk.Context) packetforward.IBCMiddleware {
+	p := im.keeper.GetParams(ctx)
+	return packetforward.NewIBCMiddleware(im.app, im.packetForwardKeeper, uint8(p.RetriesOnTimeout), p.ForwardTimeout)
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID, counterpartyChannelID, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The transfer forwarding
+// the packet, if any, is charged the fee.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	return im.packetForward(ctx).OnRecvPacket(keeper.WithReceivedPacket(ctx, packet), packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The fee of an
+// acknowledged forward is settled.
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	// TimeoutShouldRetry only reads the in-flight packet, before the
+	// middleware clears it
+	inFlightPacket, _ := im.packetForwardKeeper.TimeoutShouldRetry(ctx, packet)
+
+	if err := im.packetForward(ctx).OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	if inFlightPacket == nil {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+
+	// funds of nonrefundable packets are not refunded, the ack of the
+	// received packet is a success
+	return im.keeper.SettleFee(ctx, packet, inFlightPacket, ack.Success() || inFlightPacket.Nonrefundable)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The fee of a forward
+// timing out without retries left is settled.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	inFlightPacket, _ := im.packetForwardKeeper.TimeoutShouldRetry(ctx, packet)
+
+	if err := im.packetForward(ctx).OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	if inFlightPacket == nil || inFlightPacket.RetriesRemaining > 0 {
+		return nil
+	}
+
+	return im.keeper.SettleFee(ctx, packet, inFlightPacket, inFlightPacket.Nonrefundable)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	return im.packetForwardKeeper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return im.packetForwardKeeper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4 Wrapper interface
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.packetForwardKeeper.GetAppVersion(ctx, portID, channelID)
+}
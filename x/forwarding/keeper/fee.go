@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/outbe/outbe-node/x/forwarding/types"
+)
+
+// receivedPacketKey is the context key of the packet being received.
+type receivedPacketKey struct{}
+
+// WithReceivedPacket marks ctx as the reception of packet, so that the
+// transfer forwarding it is charged a fee.
+func WithReceivedPacket(ctx sdk.Context, packet channeltypes.Packet) sdk.Context {
+	return ctx.WithValue(receivedPacketKey{}, packet)
+}
+
+// receivedPacket returns the packet ctx was marked with.
+func receivedPacket(ctx sdk.Context) (channeltypes.Packet, bool) {
+	packet, ok := ctx.Value(receivedPacketKey{}).(channeltypes.Packet)
+	return packet, ok
+}
+
+var _ packetforwardtypes.TransferKeeper = transferKeeper{}
+
+// transferKeeper charges the fee on the transfers the packet-forward
+// middleware sends while receiving a packet. Retries are sent from
+// timeouts and are not charged again.
+type transferKeeper struct {
+	packetforwardtypes.TransferKeeper
+
+	k Keeper
+}
+
+// TransferKeeper wraps the transfer keeper of the packet-forward middleware
+// with the forward fee.
+func (k Keeper) TransferKeeper(tk packetforwardtypes.TransferKeeper) packetforwardtypes.TransferKeeper {
+	return transferKeeper{TransferKeeper: tk, k: k}
+}
+
+// Transfer takes the fee from the forwarded token before sending it.
+func (tk transferKeeper) Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if packet, ok := receivedPacket(ctx); ok {
+		fee, err := tk.k.chargeFee(ctx, packet, msg.Sender, msg.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		forward := *msg
+		forward.Token = msg.Token.Sub(fee)
+		msg = &forward
+	}
+
+	return tk.TransferKeeper.Transfer(goCtx, msg)
+}
+
+// chargeFee moves the fee of a forward of token from sender to the module
+// account, where it is held until the forward is acknowledged.
+func (k Keeper) chargeFee(ctx sdk.Context, packet channeltypes.Packet, sender string, token sdk.Coin) (sdk.Coin, error) {
+	fee := sdk.NewCoin(token.Denom, k.GetParams(ctx).Fee(token.Amount))
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	from, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return fee, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return fee, errorsmod.Wrap(err, "forward fee")
+	}
+
+	pending := types.PendingFee{
+		PortId:    packet.DestinationPort,
+		ChannelId: packet.DestinationChannel,
+		Sequence:  packet.Sequence,
+		Fee:       fee,
+	}
+	if err := k.PendingFees.Set(ctx, collections.Join3(pending.PortId, pending.ChannelId, pending.Sequence), pending); err != nil {
+		return fee, err
+	}
+
+	return fee, ctx.EventManager().EmitTypedEvent(&types.EventForwardFeeCharged{
+		PortId:    pending.PortId,
+		ChannelId: pending.ChannelId,
+		Sequence:  pending.Sequence,
+		Fee:       fee.String(),
+	})
+}
+
+// SettleFee releases the fee held for the forward of inFlightPacket once
+// packet, the forward, is acknowledged for good. The fee of a successful
+// forward is paid to the community pool. The fee of a failed one follows
+// the forwarded funds back to the escrow of the received packet, or is
+// burned with them, so that the refund of the sender chain is covered.
+func (k Keeper) SettleFee(ctx sdk.Context, packet channeltypes.Packet, inFlightPacket *packetforwardtypes.InFlightPacket, success bool) error {
+	key := collections.Join3(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, inFlightPacket.RefundSequence)
+
+	pending, err := k.PendingFees.Get(ctx, key)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.PendingFees.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	fees := sdk.NewCoins(pending.Fee)
+	if success {
+		err = k.distrKeeper.FundCommunityPool(ctx, fees, moduleAddress())
+	} else {
+		err = k.refundFee(ctx, packet, inFlightPacket, pending.Fee)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardFeeSettled{
+		PortId:    pending.PortId,
+		ChannelId: pending.ChannelId,
+		Sequence:  pending.Sequence,
+		Fee:       pending.Fee.String(),
+		Refunded:  !success,
+	})
+}
+
+// refundFee mirrors what the packet-forward middleware does with the funds
+// of a failed forward: they are burned when they were minted on receive and
+// go back to the refund escrow otherwise.
+func (k Keeper) refundFee(ctx sdk.Context, packet channeltypes.Packet, inFlightPacket *packetforwardtypes.InFlightPacket, fee sdk.Coin) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return err
+	}
+
+	fullDenomPath := data.Denom
+	if strings.HasPrefix(data.Denom, "ibc/") {
+		path, err := k.transferKeeper.DenomPathFromHash(ctx, data.Denom)
+		if err != nil {
+			return err
+		}
+		fullDenomPath = path
+	}
+
+	fees := sdk.NewCoins(fee)
+	if transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) &&
+		!transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+	}
+
+	refundEscrow := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundEscrow, fees); err != nil {
+		return err
+	}
+
+	total := k.transferKeeper.GetTotalEscrowForDenom(ctx, fee.Denom)
+	k.transferKeeper.SetTotalEscrowForDenom(ctx, total.Add(fee))
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/outbe/outbe-node/x/forwarding/types"
+)
+
+type Keeper struct {
+	cdc codec.Codec
+
+	logger log.Logger
+
+	// state management
+	Schema      collections.Schema
+	PendingFees collections.Map[collections.Triple[string, string, uint64], types.PendingFee]
+
+	// paramSpace is the subspace of the packet-forward middleware
+	paramSpace paramtypes.Subspace
+	nodeConfig types.NodeConfig
+
+	// packetForwardStoreService is the store of the in-flight packets of
+	// the packet-forward middleware
+	packetForwardStoreService storetypes.KVStoreService
+
+	transferKeeper types.TransferKeeper
+	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistributionKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	paramSpace paramtypes.Subspace,
+	nodeConfig types.NodeConfig,
+	packetForwardStoreService storetypes.KVStoreService,
+	transferKeeper types.TransferKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		PendingFees: collections.NewMap(
+			sb, types.PendingFeesKey, "pending_fees",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PendingFee](cdc),
+		),
+
+		paramSpace:                paramSpace,
+		nodeConfig:                nodeConfig,
+		packetForwardStoreService: packetForwardStoreService,
+
+		transferKeeper: transferKeeper,
+		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// for the ones not stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(sdk.UnwrapSDKContext(ctx), &p)
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	k.paramSpace.SetParamSet(sdk.UnwrapSDKContext(ctx), &p)
+	return nil
+}
+
+// GetAllPendingFees returns every held fee ordered by received packet.
+func (k Keeper) GetAllPendingFees(ctx context.Context) ([]types.PendingFee, error) {
+	var fees []types.PendingFee
+	err := k.PendingFees.Walk(ctx, nil, func(_ collections.Triple[string, string, uint64], fee types.PendingFee) (bool, error) {
+		fees = append(fees, fee)
+		return false, nil
+	})
+
+	return fees, err
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, f := range data.PendingFees {
+		if err := k.PendingFees.Set(ctx, collections.Join3(f.PortId, f.ChannelId, f.Sequence), f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	fees, err := k.GetAllPendingFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), fees)
+}
+
+// moduleAddress returns the account holding the pending fees.
+func moduleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
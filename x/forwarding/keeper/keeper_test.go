@@ -0,0 +1,386 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/outbe/outbe-node/x/forwarding/keeper"
+	"github.com/outbe/outbe-node/x/forwarding/types"
+)
+
+const (
+	recvChannel    = "channel-0"
+	forwardChannel = "channel-1"
+	native         = "uoutbe"
+)
+
+var (
+	sender    = sdk.AccAddress([]byte("override_receiver___"))
+	moduleAcc = authtypes.NewModuleAddress(types.ModuleName)
+)
+
+// mockTransfer records the transfers sent and the total escrows.
+type mockTransfer struct {
+	sent    []transfertypes.MsgTransfer
+	escrows map[string]sdkmath.Int
+}
+
+func (m *mockTransfer) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	m.sent = append(m.sent, *msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.sent))}, nil
+}
+
+func (m *mockTransfer) DenomPathFromHash(_ sdk.Context, denom string) (string, error) {
+	return denom, nil
+}
+
+func (m *mockTransfer) GetTotalEscrowForDenom(_ sdk.Context, denom string) sdk.Coin {
+	if amount, found := m.escrows[denom]; found {
+		return sdk.NewCoin(denom, amount)
+	}
+	return sdk.NewCoin(denom, sdkmath.ZeroInt())
+}
+
+func (m *mockTransfer) SetTotalEscrowForDenom(_ sdk.Context, coin sdk.Coin) {
+	m.escrows[coin.Denom] = coin.Amount
+}
+
+func (m *mockTransfer) GetPort(_ sdk.Context) string {
+	return transfertypes.PortID
+}
+
+// mockBank holds balances by address, module accounts included.
+type mockBank struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func (m *mockBank) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[from.String()] = balance
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBank) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return m.send(from, authtypes.NewModuleAddress(module), amt)
+}
+
+func (m *mockBank) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(module), to, amt)
+}
+
+func (m *mockBank) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	if err := m.send(authtypes.NewModuleAddress(module), authtypes.NewModuleAddress("burned"), amt); err != nil {
+		return err
+	}
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+// mockDistr holds the community pool.
+type mockDistr struct {
+	bank *mockBank
+	pool sdk.Coins
+}
+
+func (m *mockDistr) FundCommunityPool(_ context.Context, amount sdk.Coins, from sdk.AccAddress) error {
+	if err := m.bank.send(from, authtypes.NewModuleAddress("distribution"), amount); err != nil {
+		return err
+	}
+	m.pool = m.pool.Add(amount...)
+	return nil
+}
+
+type fixture struct {
+	ctx      sdk.Context
+	k        keeper.Keeper
+	cdc      codec.Codec
+	pfmKey   *storetypes.KVStoreKey
+	transfer *mockTransfer
+	bank     *mockBank
+	distr    *mockDistr
+}
+
+func setupKeeper(t *testing.T, nodeConfig types.NodeConfig) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	pfmKey := storetypes.NewKVStoreKey(packetforwardtypes.StoreKey)
+	paramsKey := storetypes.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: key, packetforwardtypes.StoreKey: pfmKey, paramtypes.StoreKey: paramsKey},
+		map[string]*storetypes.TransientStoreKey{paramtypes.TStoreKey: paramsTKey},
+		nil,
+	)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	f := fixture{
+		ctx:      ctx,
+		cdc:      encCfg.Codec,
+		pfmKey:   pfmKey,
+		transfer: &mockTransfer{escrows: map[string]sdkmath.Int{}},
+		bank:     bank,
+		distr:    &mockDistr{bank: bank},
+	}
+
+	f.k = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, packetforwardtypes.ModuleName),
+		nodeConfig,
+		runtime.NewKVStoreService(pfmKey),
+		f.transfer,
+		f.bank,
+		f.distr,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return f
+}
+
+// received returns a packet received on recvChannel, forwarded by the
+// middleware.
+func received(sequence uint64) channeltypes.Packet {
+	return channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-9",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: recvChannel,
+	}
+}
+
+// forwarded returns the forward of denom sent on forwardChannel, and the
+// in-flight packet of the received packet it is the forward of.
+func forwarded(sequence, refundSequence uint64, denom string) (channeltypes.Packet, *packetforwardtypes.InFlightPacket) {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "90", sender.String(), "receiver", "")
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: forwardChannel,
+		Data:          data.GetBytes(),
+	}
+
+	return packet, &packetforwardtypes.InFlightPacket{
+		RefundPortId:    transfertypes.PortID,
+		RefundChannelId: recvChannel,
+		RefundSequence:  refundSequence,
+	}
+}
+
+func joinKey(sequence uint64) collections.Triple[string, string, uint64] {
+	return collections.Join3(transfertypes.PortID, recvChannel, sequence)
+}
+
+func TestParams(t *testing.T) {
+	f := setupKeeper(t, types.DefaultNodeConfig())
+
+	// nothing is stored in the subspace before genesis or an upgrade
+	require.Equal(t, types.DefaultParams(), f.k.GetParams(f.ctx))
+
+	params := types.NewParams(3, time.Hour, sdkmath.LegacyNewDecWithPrec(1, 2))
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.NewGenesisState(params, nil)))
+	require.Equal(t, params, f.k.GetParams(f.ctx))
+	require.Equal(t, params, f.k.ExportGenesis(f.ctx).Params)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t, types.DefaultNodeConfig())
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	params := types.NewParams(5, 10*time.Minute, sdkmath.LegacyNewDecWithPrec(5, 3))
+
+	_, err := ms.UpdateParams(f.ctx, types.NewMsgUpdateParams(sender, params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, f.k.GetParams(f.ctx))
+
+	for _, invalid := range []types.Params{
+		types.NewParams(256, time.Minute, sdkmath.LegacyZeroDec()),
+		types.NewParams(0, 0, sdkmath.LegacyZeroDec()),
+		types.NewParams(0, time.Minute, sdkmath.LegacyOneDec()),
+		types.NewParams(0, time.Minute, sdkmath.LegacyNewDec(-1)),
+	} {
+		_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: invalid})
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	}
+}
+
+func TestForwardFee(t *testing.T) {
+	f := setupKeeper(t, types.DefaultNodeConfig())
+	require.NoError(t, f.k.SetParams(f.ctx, types.NewParams(0, time.Hour, sdkmath.LegacyNewDecWithPrec(1, 1))))
+
+	tk := f.k.TransferKeeper(f.transfer)
+	transfer := func(ctx sdk.Context) error {
+		_, err := tk.Transfer(ctx, transfertypes.NewMsgTransfer(transfertypes.PortID, forwardChannel, sdk.NewInt64Coin(native, 100), sender.String(), "receiver", clienttypes.ZeroHeight(), 0, ""))
+		return err
+	}
+
+	// retries are sent outside of the reception of a packet
+	f.bank.balances[sender.String()] = sdk.NewCoins(sdk.NewInt64Coin(native, 300))
+	require.NoError(t, transfer(f.ctx))
+	require.Equal(t, int64(100), f.transfer.sent[0].Token.Amount.Int64())
+
+	require.NoError(t, transfer(keeper.WithReceivedPacket(f.ctx, received(1))))
+	require.Equal(t, int64(90), f.transfer.sent[1].Token.Amount.Int64())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(native, 10)), f.bank.balances[moduleAcc.String()])
+
+	fees, err := f.k.GetAllPendingFees(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingFee{{PortId: transfertypes.PortID, ChannelId: recvChannel, Sequence: 1, Fee: sdk.NewInt64Coin(native, 10)}}, fees)
+
+	// the fee of a successful forward goes to the community pool
+	packet, inFlight := forwarded(2, 1, native)
+	require.NoError(t, f.k.SettleFee(f.ctx, packet, inFlight, true))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(native, 10)), f.distr.pool)
+	require.True(t, f.bank.balances[moduleAcc.String()].IsZero())
+
+	// settling twice, or a forward without fee, is a no-op
+	require.NoError(t, f.k.SettleFee(f.ctx, packet, inFlight, true))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(native, 10)), f.distr.pool)
+
+	// the fee of a failed forward of a native token goes back to the escrow
+	// it was unescrowed from on receive
+	require.NoError(t, transfer(keeper.WithReceivedPacket(f.ctx, received(2))))
+	packet, inFlight = forwarded(3, 2, native)
+	require.NoError(t, f.k.SettleFee(f.ctx, packet, inFlight, false))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, recvChannel)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(native, 10)), f.bank.balances[escrow.String()])
+	require.Equal(t, int64(10), f.transfer.escrows[native].Int64())
+
+	// vouchers minted on receive are burned
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, recvChannel, "uatom"))
+	f.bank.balances[moduleAcc.String()] = sdk.NewCoins(sdk.NewInt64Coin(voucher.IBCDenom(), 10))
+	require.NoError(t, f.k.PendingFees.Set(f.ctx, joinKey(3), types.PendingFee{PortId: transfertypes.PortID, ChannelId: recvChannel, Sequence: 3, Fee: sdk.NewInt64Coin(voucher.IBCDenom(), 10)}))
+	packet, inFlight = forwarded(4, 3, voucher.GetFullDenomPath())
+	require.NoError(t, f.k.SettleFee(f.ctx, packet, inFlight, false))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher.IBCDenom(), 10)), f.bank.burned)
+
+	fees, err = f.k.GetAllPendingFees(f.ctx)
+	require.NoError(t, err)
+	require.Empty(t, fees)
+
+	// without funds for the fee the forward fails
+	f.bank.balances[sender.String()] = sdk.NewCoins()
+	require.Error(t, transfer(keeper.WithReceivedPacket(f.ctx, received(4))))
+}
+
+func TestInFlightPackets(t *testing.T) {
+	f := setupKeeper(t, types.DefaultNodeConfig())
+	q := keeper.NewQuerier(f.k)
+
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "override", `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`)
+	store := f.ctx.KVStore(f.pfmKey)
+	for _, forward := range []struct {
+		channel  string
+		sequence uint64
+	}{{forwardChannel, 2}, {forwardChannel, 1}, {forwardChannel + "0", 3}} {
+		store.Set(packetforwardtypes.RefundPacketKey(forward.channel, transfertypes.PortID, forward.sequence), f.cdc.MustMarshal(&packetforwardtypes.InFlightPacket{
+			PacketData:            data.GetBytes(),
+			OriginalSenderAddress: "cosmos1sender",
+			RefundChannelId:       recvChannel,
+			RefundPortId:          transfertypes.PortID,
+			RefundSequence:        forward.sequence + 10,
+			RetriesRemaining:      2,
+			Timeout:               uint64(time.Hour),
+		}))
+	}
+	fee := sdk.NewInt64Coin(native, 10)
+	require.NoError(t, f.k.PendingFees.Set(f.ctx, joinKey(11), types.PendingFee{PortId: transfertypes.PortID, ChannelId: recvChannel, Sequence: 11, Fee: fee}))
+
+	res, err := q.InFlightPackets(f.ctx, &types.QueryInFlightPacketsRequest{ChannelId: forwardChannel})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 2)
+	require.Equal(t, types.InFlightPacket{
+		PortId:                transfertypes.PortID,
+		ChannelId:             forwardChannel,
+		Sequence:              1,
+		RefundPortId:          transfertypes.PortID,
+		RefundChannelId:       recvChannel,
+		RefundSequence:        11,
+		OriginalSenderAddress: "cosmos1sender",
+		Denom:                 "uatom",
+		Amount:                "100",
+		Memo:                  data.Memo,
+		RetriesRemaining:      2,
+		Timeout:               time.Hour,
+		Fee:                   fee,
+	}, res.Packets[0])
+	require.Equal(t, uint64(2), res.Packets[1].Sequence)
+	require.True(t, res.Packets[1].Fee.IsNil())
+
+	// the channel matches the forwarded channel as a whole
+	for channel, n := range map[string]int{"": 3, forwardChannel + "0": 1, recvChannel: 0} {
+		res, err = q.InFlightPackets(f.ctx, &types.QueryInFlightPacketsRequest{ChannelId: channel})
+		require.NoError(t, err)
+		require.Len(t, res.Packets, n, channel)
+	}
+
+	// pages follow the store order
+	res, err = q.InFlightPackets(f.ctx, &types.QueryInFlightPacketsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = q.InFlightPackets(f.ctx, &types.QueryInFlightPacketsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 1)
+	require.Equal(t, forwardChannel+"0", res.Packets[0].ChannelId)
+	require.Equal(t, uint64(3), res.Packets[0].Sequence)
+	require.Nil(t, res.Pagination.NextKey)
+}
+
+func TestNodeConfig(t *testing.T) {
+	f := setupKeeper(t, types.NodeConfig{MaxInFlightPacketsLimit: 2})
+	q := keeper.NewQuerier(f.k)
+
+	res, err := q.Params(f.ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.NodeConfig{MaxInFlightPacketsLimit: 2}, res.NodeConfig)
+
+	store := f.ctx.KVStore(f.pfmKey)
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		store.Set(packetforwardtypes.RefundPacketKey(forwardChannel, transfertypes.PortID, sequence), f.cdc.MustMarshal(&packetforwardtypes.InFlightPacket{}))
+	}
+
+	// pages are capped whether or not the request sets a limit
+	for _, tc := range []struct {
+		page *query.PageRequest
+		n    int
+	}{{nil, 2}, {&query.PageRequest{Limit: 3}, 2}, {&query.PageRequest{Limit: 1}, 1}} {
+		res, err := q.InFlightPackets(f.ctx, &types.QueryInFlightPacketsRequest{Pagination: tc.page})
+		require.NoError(t, err)
+		require.Len(t, res.Packets, tc.n)
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/outbe/outbe-node/x/forwarding/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params and the config of the node.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c), NodeConfig: k.nodeConfig}, nil
+}
+
+// InFlightPackets returns the forwarded packets not acknowledged yet, with
+// the fee held for them, paginated over the store of the packet-forward
+// middleware. The page size is capped by the config of the node.
+func (k Querier) InFlightPackets(c context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// keys are channel/port/sequence of the forwarded packet
+	store := runtime.KVStoreAdapter(k.packetForwardStoreService.OpenKVStore(ctx))
+	if req.ChannelId != "" {
+		store = prefix.NewStore(store, []byte(req.ChannelId+"/"))
+	}
+
+	packets := []types.InFlightPacket{}
+	pageRes, err := query.Paginate(store, k.nodeConfig.InFlightPacketsPage(req.Pagination), func(key, value []byte) error {
+		var p packetforwardtypes.InFlightPacket
+		if err := k.cdc.Unmarshal(value, &p); err != nil {
+			return err
+		}
+
+		channelID, rest := req.ChannelId, string(key)
+		if channelID == "" {
+			channelID, rest, _ = strings.Cut(rest, "/")
+		}
+		portID, seq, found := strings.Cut(rest, "/")
+		if !found {
+			return nil
+		}
+		sequence, err := strconv.ParseUint(seq, 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		packet := types.InFlightPacket{
+			PortId:                portID,
+			ChannelId:             channelID,
+			Sequence:              sequence,
+			RefundPortId:          p.RefundPortId,
+			RefundChannelId:       p.RefundChannelId,
+			RefundSequence:        p.RefundSequence,
+			OriginalSenderAddress: p.OriginalSenderAddress,
+			RetriesRemaining:      p.RetriesRemaining,
+			Timeout:               time.Duration(p.Timeout),
+			Nonrefundable:         p.Nonrefundable,
+		}
+
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(p.PacketData, &data); err == nil {
+			packet.Denom = data.Denom
+			packet.Amount = data.Amount
+			packet.Memo = data.Memo
+		}
+
+		fee, err := k.PendingFees.Get(ctx, collections.Join3(p.RefundPortId, p.RefundChannelId, p.RefundSequence))
+		if err == nil {
+			packet.Fee = fee.Fee
+		}
+
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// DefaultMaxInFlightPacketsLimit is the default page size cap of the in-flight
+// packets query.
+const DefaultMaxInFlightPacketsLimit = 1000
+
+// DefaultNodeConfig returns the default node config.
+func DefaultNodeConfig() NodeConfig {
+	return NodeConfig{
+		MaxInFlightPacketsLimit: DefaultMaxInFlightPacketsLimit,
+	}
+}
+
+// InFlightPacketsPage returns the page of an in-flight packets query with its
+// limit capped by the node config.
+func (c NodeConfig) InFlightPacketsPage(page *query.PageRequest) *query.PageRequest {
+	if c.MaxInFlightPacketsLimit == 0 {
+		return page
+	}
+
+	capped := query.PageRequest{}
+	if page != nil {
+		capped = *page
+	}
+	if capped.Limit == 0 {
+		capped.Limit = query.DefaultLimit
+	}
+	capped.Limit = min(capped.Limit, c.MaxInFlightPacketsLimit)
+
+	return &capped
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pendingFees []PendingFee) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		PendingFees: pendingFees,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams(), []PendingFee{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.PendingFees))
+	for _, f := range gs.PendingFees {
+		if err := host.PortIdentifierValidator(f.PortId); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+
+		if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+
+		if err := f.Fee.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "pending fee of %s/%s/%d: %s", f.PortId, f.ChannelId, f.Sequence, err)
+		}
+
+		key := fmt.Sprintf("%s/%s/%d", f.PortId, f.ChannelId, f.Sequence)
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate pending fee of %s", key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// PendingFeesKey saves the fees held for forwards, by received packet.
+	PendingFeesKey = collections.NewPrefix(0)
+)
+
+const (
+	ModuleName = "forwarding"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
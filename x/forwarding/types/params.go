@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// Parameter store keys of the packet-forward middleware subspace.
+var (
+	KeyRetriesOnTimeout = []byte("RetriesOnTimeout")
+	KeyForwardTimeout   = []byte("ForwardTimeout")
+	KeyFeePercentage    = []byte("FeePercentage")
+)
+
+// DefaultForwardTimeout is the timeout of forwarded packets used by the
+// packet-forward middleware, the ICS-20 default.
+var DefaultForwardTimeout = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table of the packet-forward middleware
+// subspace.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns default module parameters, the ones the middleware
+// was built with before they were made params: no retries, the default
+// timeout and no fee.
+func DefaultParams() Params {
+	return Params{
+		RetriesOnTimeout: 0,
+		ForwardTimeout:   DefaultForwardTimeout,
+		FeePercentage:    sdkmath.LegacyZeroDec(),
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(retriesOnTimeout uint32, forwardTimeout time.Duration, feePercentage sdkmath.LegacyDec) Params {
+	return Params{
+		RetriesOnTimeout: retriesOnTimeout,
+		ForwardTimeout:   forwardTimeout,
+		FeePercentage:    feePercentage,
+	}
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRetriesOnTimeout, &p.RetriesOnTimeout, validateRetriesOnTimeout),
+		paramtypes.NewParamSetPair(KeyForwardTimeout, &p.ForwardTimeout, validateForwardTimeout),
+		paramtypes.NewParamSetPair(KeyFeePercentage, &p.FeePercentage, validateFeePercentage),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := validateRetriesOnTimeout(p.RetriesOnTimeout); err != nil {
+		return err
+	}
+
+	if err := validateForwardTimeout(p.ForwardTimeout); err != nil {
+		return err
+	}
+
+	return validateFeePercentage(p.FeePercentage)
+}
+
+// Fee returns the fee taken from a forward of amount.
+func (p Params) Fee(amount sdkmath.Int) sdkmath.Int {
+	if p.FeePercentage.IsNil() || !p.FeePercentage.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+
+	return p.FeePercentage.MulInt(amount).TruncateInt()
+}
+
+// validateRetriesOnTimeout checks the retries fit the uint8 of the
+// middleware.
+func validateRetriesOnTimeout(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > math.MaxUint8 {
+		return errorsmod.Wrapf(ErrInvalidParams, "retries on timeout %d above %d", v, math.MaxUint8)
+	}
+
+	return nil
+}
+
+func validateForwardTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "forward timeout %s not positive", v)
+	}
+
+	return nil
+}
+
+// validateFeePercentage checks the fee leaves something to forward.
+func validateFeePercentage(i interface{}) error {
+	v, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "fee percentage %s not in [0, 1)", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/globalfee/keeper"
+	"github.com/outbe/outbe-node/x/globalfee/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx, k
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	for _, tc := range []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"multiple denoms", types.NewParams(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("unit", sdkmath.LegacyMustNewDecFromStr("0.0025")),
+			sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyMustNewDecFromStr("0.01")),
+		), nil, 0), true},
+		{"unsorted denoms", types.NewParams(sdk.DecCoins{
+			sdk.NewDecCoinFromDec("unit", sdkmath.LegacyOneDec()),
+			sdk.NewDecCoinFromDec("uatom", sdkmath.LegacyOneDec()),
+		}, nil, 0), false},
+		{"zero price", types.NewParams(sdk.DecCoins{sdk.NewDecCoinFromDec("unit", sdkmath.LegacyZeroDec())}, nil, 0), false},
+		{"invalid type url", types.NewParams(nil, []string{"ibc.core.client.v1.MsgUpdateClient"}, 0), false},
+		{"duplicate type url", types.NewParams(nil, []string{"/a.Msg", "/a.Msg"}, 0), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	prices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("unit", sdkmath.LegacyMustNewDecFromStr("0.0025")))
+	params := types.NewParams(prices, types.DefaultBypassMinFeeMsgTypes(), 500_000)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.Error(t, err)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(nil, []string{"bad"}, 0),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTypeURL)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	res, err := keeper.NewQuerier(k).MinimumGasPrices(ctx, &types.QueryMinimumGasPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, prices, res.MinimumGasPrices)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/outbe/outbe-node/x/globalfee/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// MinimumGasPrices returns the chain wide minimum gas prices.
+func (k Querier) MinimumGasPrices(c context.Context, _ *types.QueryMinimumGasPricesRequest) (*types.QueryMinimumGasPricesResponse, error) {
+	return &types.QueryMinimumGasPricesResponse{MinimumGasPrices: k.GetParams(c).MinimumGasPrices}, nil
+}
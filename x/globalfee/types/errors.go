@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidGasPrices = errorsmod.Register(ModuleName, 1, "invalid minimum gas prices")
+	ErrInvalidTypeURL   = errorsmod.Register(ModuleName, 2, "invalid message type url")
+)
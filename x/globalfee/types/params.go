@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default gas cap for txs made
+// only of bypass messages.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+// DefaultBypassMinFeeMsgTypes are the IBC relayer messages that may be sent
+// without a fee by default.
+func DefaultBypassMinFeeMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}),
+		sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}),
+		sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{}),
+		sdk.MsgTypeURL(&channeltypes.MsgTimeout{}),
+		sdk.MsgTypeURL(&channeltypes.MsgTimeoutOnClose{}),
+	}
+}
+
+// DefaultParams returns default module parameters. No global minimum is set,
+// leaving the fee to the validators' local minimum gas prices.
+func DefaultParams() Params {
+	return Params{
+		MinimumGasPrices:                sdk.DecCoins{},
+		BypassMinFeeMsgTypes:            DefaultBypassMinFeeMsgTypes(),
+		MaxTotalBypassMinFeeMsgGasUsage: DefaultMaxTotalBypassMinFeeMsgGasUsage,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(minGasPrices sdk.DecCoins, bypassMsgTypes []string, maxBypassGas uint64) Params {
+	return Params{
+		MinimumGasPrices:                minGasPrices,
+		BypassMinFeeMsgTypes:            bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: maxBypassGas,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := p.MinimumGasPrices.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidGasPrices, err.Error())
+	}
+
+	seen := make(map[string]bool, len(p.BypassMinFeeMsgTypes))
+	for _, typeURL := range p.BypassMinFeeMsgTypes {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) < 2 || strings.ContainsAny(typeURL, " \t\n") {
+			return errorsmod.Wrapf(ErrInvalidTypeURL, "%q must be of the form /package.Msg", typeURL)
+		}
+
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidTypeURL, "duplicate type url %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// IsBypassMsgType reports whether the type url may be sent without a fee.
+func (p Params) IsBypassMsgType(typeURL string) bool {
+	for _, t := range p.BypassMinFeeMsgTypes {
+		if t == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
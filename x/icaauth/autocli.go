@@ -0,0 +1,58 @@
+package icaauth
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "icaauth.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current icaauth parameters",
+				},
+				{
+					RpcMethod: "InterchainAccount",
+					Use:       "interchain-account [owner] [connection-id]",
+					Short:     "Query the interchain account of an owner on a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "connection_id"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "icaauth.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+				{
+					RpcMethod: "RegisterInterchainAccount",
+					Use:       "register [connection-id]",
+					Short:     "Register an interchain account owned by the sender",
+					Long:      "Register an interchain account owned by the sender. The sender must be approved by governance; contracts and group policies use the message directly.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "connection_id"},
+					},
+				},
+				{
+					RpcMethod: "SubmitTx",
+					Use:       "submit-tx [connection-id] [relative-timeout]",
+					Short:     "Execute messages with the interchain account of the sender",
+					Long:      "Execute messages with the interchain account of the sender. Messages are given as JSON with --messages, e.g. '{\"@type\":\"/cosmos.bank.v1beta1.MsgSend\",...}', and the relative timeout in nanoseconds.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "connection_id"},
+						{ProtoField: "relative_timeout"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package icaauth
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/outbe/outbe-node/x/icaauth/keeper"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the authentication module under the ICA controller
+// middleware. The middleware only calls it for accounts registered through
+// x/icaauth; accounts registered with the controller messages keep working
+// without callbacks, under the packet policy of the keeper.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version is set by
+// the controller middleware and returned unchanged.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (IBCModule) OnChanOpenAck(_ sdk.Context, _, _, _, _ string) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (IBCModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The controller middleware
+// rejects packets before they reach the authentication module.
+func (IBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) error {
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	return im.keeper.OnTimeoutPacket(ctx, packet)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+// ICALifecycleCompleteMsg is the sudo message sent to owner contracts when a
+// packet of their interchain account is acknowledged or times out. It mirrors
+// the ibc_lifecycle_complete message of ibc-hooks.
+type ICALifecycleCompleteMsg struct {
+	ICALifecycleComplete ICALifecycleComplete `json:"ica_lifecycle_complete"`
+}
+
+// ICALifecycleComplete holds exactly one of the ack or timeout outcomes.
+type ICALifecycleComplete struct {
+	ICAAck     *ICAAck     `json:"ica_ack,omitempty"`
+	ICATimeout *ICATimeout `json:"ica_timeout,omitempty"`
+}
+
+// ICAAck describes an acknowledged packet. Ack is the raw acknowledgement
+// written by the host chain.
+type ICAAck struct {
+	ConnectionID string `json:"connection_id"`
+	Channel      string `json:"channel"`
+	Sequence     uint64 `json:"sequence"`
+	Ack          []byte `json:"ack"`
+	Success      bool   `json:"success"`
+}
+
+// ICATimeout describes a timed out packet.
+type ICATimeout struct {
+	ConnectionID string `json:"connection_id"`
+	Channel      string `json:"channel"`
+	Sequence     uint64 `json:"sequence"`
+}
+
+// OnAcknowledgementPacket reports the acknowledgement of a packet to the
+// account owner.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	success := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	return k.packetLifecycleComplete(ctx, packet, success, false, func(connectionID string) ICALifecycleComplete {
+		return ICALifecycleComplete{ICAAck: &ICAAck{
+			ConnectionID: connectionID,
+			Channel:      packet.SourceChannel,
+			Sequence:     packet.Sequence,
+			Ack:          acknowledgement,
+			Success:      success,
+		}}
+	})
+}
+
+// OnTimeoutPacket reports the timeout of a packet to the account owner.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.packetLifecycleComplete(ctx, packet, false, true, func(connectionID string) ICALifecycleComplete {
+		return ICALifecycleComplete{ICATimeout: &ICATimeout{
+			ConnectionID: connectionID,
+			Channel:      packet.SourceChannel,
+			Sequence:     packet.Sequence,
+		}}
+	})
+}
+
+// packetLifecycleComplete calls the owner back if it is a contract and emits
+// EventPacketLifecycleComplete. Callback failures are reported in the event
+// and never returned, as that would leave the packet unacknowledged forever.
+func (k Keeper) packetLifecycleComplete(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	success, timeout bool,
+	callback func(connectionID string) ICALifecycleComplete,
+) error {
+	owner, ok := k.packetOwner(ctx, packet.SourcePort, packet.SourceChannel)
+	if !ok {
+		return nil
+	}
+
+	connectionID, err := k.controllerKeeper.GetConnectionID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+
+	var callbackErr string
+	if ownerAddr, err := sdk.AccAddressFromBech32(owner); err == nil && k.contractViewKeeper.HasContractInfo(ctx, ownerAddr) {
+		if err := k.callContract(ctx, ownerAddr, ICALifecycleCompleteMsg{ICALifecycleComplete: callback(connectionID)}); err != nil {
+			k.Logger().Error("interchain account callback failed", "owner", owner, "channel", packet.SourceChannel, "sequence", packet.Sequence, "error", err)
+			callbackErr = err.Error()
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPacketLifecycleComplete{
+		Owner:         owner,
+		ConnectionId:  connectionID,
+		ChannelId:     packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Success:       success,
+		Timeout:       timeout,
+		CallbackError: callbackErr,
+	})
+}
+
+// callContract sudo calls the owner contract in a cached context with a gas
+// meter capped at the callback_gas_limit param. Its state changes are only
+// kept if the call succeeds. The gas used is charged to the parent context.
+func (k Keeper) callContract(ctx sdk.Context, contract sdk.AccAddress, msg ICALifecycleCompleteMsg) (err error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	limit := k.GetParams(ctx).CallbackGasLimit
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(limit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "icaauth callback")
+
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas, limit %d", limit)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(cacheCtx, contract, bz); err != nil {
+		return err
+	}
+
+	write()
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket enforces the owner and message policy on every packet of an
+// interchain account, before passing it down the stack. This covers the
+// accounts registered with the messages of the ICA controller too: without it
+// an owner could bypass the policy with the controller MsgSendTx, or with an
+// account registered by the controller MsgRegisterInterchainAccount.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if owner, ok := strings.CutPrefix(sourcePort, icatypes.ControllerPortPrefix); ok {
+		if err := k.checkPacket(ctx, owner, sourcePort, sourceChannel, data); err != nil {
+			return 0, err
+		}
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// packetOwner returns the owner of the controller port if the interchain
+// account on the channel was registered through this module.
+func (k Keeper) packetOwner(ctx sdk.Context, portID, channelID string) (string, bool) {
+	owner, ok := strings.CutPrefix(portID, icatypes.ControllerPortPrefix)
+	if !ok {
+		return "", false
+	}
+
+	connectionID, err := k.controllerKeeper.GetConnectionID(ctx, portID, channelID)
+	if err != nil || !k.controllerKeeper.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return "", false
+	}
+
+	return owner, true
+}
+
+// checkPacket authorizes the owner and the messages of an interchain account
+// packet. Only the type URLs are decoded, so that messages of host chain
+// modules unknown to this chain can be checked too.
+func (k Keeper) checkPacket(ctx sdk.Context, owner, portID, channelID string, data []byte) error {
+	if err := k.AuthorizeOwner(ctx, owner); err != nil {
+		return err
+	}
+
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal packet data: %s", err)
+	}
+
+	if packetData.Type != icatypes.EXECUTE_TX {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "packet type %s", packetData.Type)
+	}
+
+	metadata, err := k.channelMetadata(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	typeURLs, err := packetMsgTypes(packetData.Data, metadata.Encoding)
+	if err != nil {
+		return err
+	}
+
+	return k.ValidateMsgTypes(ctx, typeURLs)
+}
+
+// packetMsgTypes returns the type URLs of the messages of a serialized
+// CosmosTx without resolving them.
+func packetMsgTypes(data []byte, encoding string) ([]string, error) {
+	var typeURLs []string
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		var tx icatypes.CosmosTx
+		if err := proto.Unmarshal(data, &tx); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx: %s", err)
+		}
+		for _, msg := range tx.Messages {
+			typeURLs = append(typeURLs, msg.TypeUrl)
+		}
+	case icatypes.EncodingProto3JSON:
+		var tx struct {
+			Messages []struct {
+				Type string `json:"@type"`
+			} `json:"messages"`
+		}
+		if err := json.Unmarshal(data, &tx); err != nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal CosmosTx: %s", err)
+		}
+		for _, msg := range tx.Messages {
+			typeURLs = append(typeURLs, msg.Type)
+		}
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding %s", encoding)
+	}
+
+	return typeURLs, nil
+}
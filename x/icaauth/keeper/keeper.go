@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+
+	"github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+type Keeper struct {
+	cdc codec.Codec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	ics4Wrapper        porttypes.ICS4Wrapper
+	controllerKeeper   types.ICAControllerKeeper
+	groupKeeper        types.GroupKeeper
+	contractKeeper     types.ContractKeeper
+	contractViewKeeper types.ContractViewKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	controllerKeeper types.ICAControllerKeeper,
+	groupKeeper types.GroupKeeper,
+	contractKeeper types.ContractKeeper,
+	contractViewKeeper types.ContractViewKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		ics4Wrapper:        ics4Wrapper,
+		controllerKeeper:   controllerKeeper,
+		groupKeeper:        groupKeeper,
+		contractKeeper:     contractKeeper,
+		contractViewKeeper: contractViewKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// AuthorizeOwner returns an error unless owner may control interchain
+// accounts: it must be approved by governance, or be a wasm contract or a
+// group policy account while those are allowed. The check is repeated on
+// every packet, so that owners lose control as soon as the params change.
+func (k Keeper) AuthorizeOwner(ctx context.Context, owner string) error {
+	params := k.GetParams(ctx)
+	if params.IsOwner(owner) {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrUnauthorizedOwner, "%s: %s", owner, err)
+	}
+
+	if params.AllowContracts && k.contractViewKeeper.HasContractInfo(ctx, addr) {
+		return nil
+	}
+
+	if params.AllowGroupPolicies {
+		if _, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: owner}); err == nil {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrap(types.ErrUnauthorizedOwner, owner)
+}
+
+// ValidateMsgTypes returns an error unless every type URL is in the
+// allowed_msg_types param.
+func (k Keeper) ValidateMsgTypes(ctx context.Context, typeURLs []string) error {
+	params := k.GetParams(ctx)
+	for _, typeURL := range typeURLs {
+		if !params.IsAllowedMsgType(typeURL) {
+			return errorsmod.Wrap(types.ErrMsgNotAllowed, typeURL)
+		}
+	}
+
+	return nil
+}
+
+// RegisterInterchainAccount opens an interchain account channel for owner on
+// connectionID and returns its controller port. The packet callbacks of the
+// account are routed to this module.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, owner, connectionID, version string) (string, error) {
+	if err := k.AuthorizeOwner(ctx, owner); err != nil {
+		return "", err
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if err := k.controllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner, version); err != nil {
+		return "", err
+	}
+
+	return portID, nil
+}
+
+// SubmitTx sends msgs to be executed by the interchain account of owner on
+// connectionID and returns the packet sequence.
+func (k Keeper) SubmitTx(ctx sdk.Context, owner, connectionID string, msgs []sdk.Msg, memo string, relativeTimeout uint64) (uint64, error) {
+	if err := k.AuthorizeOwner(ctx, owner); err != nil {
+		return 0, err
+	}
+
+	protoMsgs := make([]proto.Message, len(msgs))
+	typeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	if err := k.ValidateMsgTypes(ctx, typeURLs); err != nil {
+		return 0, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return 0, err
+	}
+
+	channelID, found := k.controllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrNoActiveChannel, "connection %s, port %s", connectionID, portID)
+	}
+
+	metadata, err := k.channelMetadata(ctx, portID, channelID)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := icatypes.SerializeCosmosTx(k.cdc, protoMsgs, metadata.Encoding)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	timeout := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+
+	return k.controllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeout)
+}
+
+// InterchainAccount returns the address and active channel of the interchain
+// account of owner on connectionID.
+func (k Keeper) InterchainAccount(ctx sdk.Context, owner, connectionID string) (address, portID, channelID string, err error) {
+	portID, err = icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	address, found := k.controllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", "", "", errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "connection %s, port %s", connectionID, portID)
+	}
+
+	channelID, _ = k.controllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+
+	return address, portID, channelID, nil
+}
+
+// channelMetadata returns the ICS-27 metadata negotiated on the channel.
+func (k Keeper) channelMetadata(ctx sdk.Context, portID, channelID string) (icatypes.Metadata, error) {
+	version, found := k.controllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return icatypes.Metadata{}, errorsmod.Wrapf(icatypes.ErrInvalidVersion, "no version for port %s, channel %s", portID, channelID)
+	}
+
+	return icatypes.MetadataFromVersion(version)
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
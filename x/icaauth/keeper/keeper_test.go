@@ -0,0 +1,426 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/outbe/outbe-node/x/icaauth/keeper"
+	"github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-0"
+)
+
+var (
+	owner       = sdk.AccAddress([]byte("approved_owner______"))
+	contract    = sdk.AccAddress([]byte("owner_contract______"))
+	groupPolicy = sdk.AccAddress([]byte("group_policy________"))
+	other       = sdk.AccAddress([]byte("other_address_______"))
+)
+
+// mockController is an ICA controller with a single open channel per
+// registered port on connectionID.
+type mockController struct {
+	ics4     func(ctx sdk.Context, portID string, data []byte) (uint64, error)
+	encoding string
+	ports    map[string]bool // port -> middleware enabled
+}
+
+func (m *mockController) RegisterInterchainAccount(_ sdk.Context, _, o, _ string) error {
+	portID, err := icatypes.NewControllerPortID(o)
+	if err != nil {
+		return err
+	}
+	m.ports[portID] = true
+	return nil
+}
+
+func (m *mockController) SendTx(ctx sdk.Context, _ *capabilitytypes.Capability, _, portID string, data icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	return m.ics4(ctx, portID, data.GetBytes())
+}
+
+func (m *mockController) GetActiveChannelID(_ sdk.Context, _, portID string) (string, bool) {
+	_, ok := m.ports[portID]
+	return channelID, ok
+}
+
+func (m *mockController) GetOpenActiveChannel(ctx sdk.Context, connID, portID string) (string, bool) {
+	return m.GetActiveChannelID(ctx, connID, portID)
+}
+
+func (m *mockController) GetInterchainAccountAddress(_ sdk.Context, _, portID string) (string, bool) {
+	_, ok := m.ports[portID]
+	return "host_account", ok
+}
+
+func (m *mockController) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	md := icatypes.NewMetadata(icatypes.Version, connectionID, connectionID, "host_account", m.encoding, icatypes.TxTypeSDKMultiMsg)
+	return string(icatypes.ModuleCdc.MustMarshalJSON(&md)), true
+}
+
+func (m *mockController) GetConnectionID(_ sdk.Context, _, _ string) (string, error) {
+	return connectionID, nil
+}
+
+func (m *mockController) IsMiddlewareEnabled(_ sdk.Context, portID, _ string) bool {
+	return m.ports[portID]
+}
+
+// mockChannel records the packets sent down the stack.
+type mockChannel struct {
+	sent [][]byte
+}
+
+func (m *mockChannel) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+	m.sent = append(m.sent, data)
+	return uint64(len(m.sent)), nil
+}
+
+func (*mockChannel) WriteAcknowledgement(sdk.Context, *capabilitytypes.Capability, ibcexported.PacketI, ibcexported.Acknowledgement) error {
+	return nil
+}
+
+func (*mockChannel) GetAppVersion(sdk.Context, string, string) (string, bool) {
+	return "", false
+}
+
+type mockGroup struct{}
+
+func (mockGroup) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if req.Address != groupPolicy.String() {
+		return nil, errors.New("not found")
+	}
+
+	return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: req.Address}}, nil
+}
+
+// mockContract records callbacks, writes to the store to check that failed
+// callbacks are reverted, and fails or burns gas when asked to.
+type mockContract struct {
+	key     storetypes.StoreKey
+	calls   []keeper.ICALifecycleCompleteMsg
+	err     error
+	gasUsed uint64
+}
+
+func (m *mockContract) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var call keeper.ICALifecycleCompleteMsg
+	if err := json.Unmarshal(msg, &call); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, call)
+
+	ctx.KVStore(m.key).Set([]byte("callback"), []byte{1})
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "callback")
+
+	return nil, m.err
+}
+
+func (*mockContract) HasContractInfo(_ context.Context, addr sdk.AccAddress) bool {
+	return addr.Equals(contract)
+}
+
+type fixture struct {
+	ctx        sdk.Context
+	cdc        codec.Codec
+	k          keeper.Keeper
+	key        storetypes.StoreKey
+	controller *mockController
+	channel    *mockChannel
+	wasm       *mockContract
+}
+
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+
+	f := fixture{
+		ctx:        testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+		cdc:        encCfg.Codec,
+		key:        key,
+		controller: &mockController{encoding: icatypes.EncodingProtobuf, ports: map[string]bool{}},
+		channel:    &mockChannel{},
+		wasm:       &mockContract{key: key},
+	}
+
+	f.k = keeper.NewKeeper(
+		f.cdc,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		f.channel,
+		f.controller,
+		mockGroup{},
+		f.wasm,
+		f.wasm,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// the controller sends its packets through the keeper like in the app
+	f.controller.ics4 = func(ctx sdk.Context, portID string, data []byte) (uint64, error) {
+		return f.k.SendPacket(ctx, nil, portID, channelID, clienttypes.ZeroHeight(), 1, data)
+	}
+
+	params := types.DefaultParams()
+	params.Owners = []string{owner.String()}
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	return f
+}
+
+func portID(t *testing.T, o sdk.AccAddress) string {
+	t.Helper()
+
+	p, err := icatypes.NewControllerPortID(o.String())
+	require.NoError(t, err)
+	return p
+}
+
+func TestAuthorizeOwner(t *testing.T) {
+	f := setupKeeper(t)
+
+	for _, o := range []sdk.AccAddress{owner, contract, groupPolicy} {
+		require.NoError(t, f.k.AuthorizeOwner(f.ctx, o.String()))
+	}
+	require.ErrorIs(t, f.k.AuthorizeOwner(f.ctx, other.String()), types.ErrUnauthorizedOwner)
+
+	// contracts and group policies can be excluded by governance
+	params := f.k.GetParams(f.ctx)
+	params.AllowContracts = false
+	params.AllowGroupPolicies = false
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	require.NoError(t, f.k.AuthorizeOwner(f.ctx, owner.String()))
+	require.ErrorIs(t, f.k.AuthorizeOwner(f.ctx, contract.String()), types.ErrUnauthorizedOwner)
+	require.ErrorIs(t, f.k.AuthorizeOwner(f.ctx, groupPolicy.String()), types.ErrUnauthorizedOwner)
+}
+
+func TestRegisterAndSubmitTx(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	_, err := ms.RegisterInterchainAccount(f.ctx, types.NewMsgRegisterInterchainAccount(other, connectionID, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorizedOwner)
+
+	res, err := ms.RegisterInterchainAccount(f.ctx, types.NewMsgRegisterInterchainAccount(contract, connectionID, ""))
+	require.NoError(t, err)
+	require.Equal(t, portID(t, contract), res.PortId)
+
+	acc, err := keeper.NewQuerier(f.k).InterchainAccount(f.ctx, &types.QueryInterchainAccountRequest{Owner: contract.String(), ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryInterchainAccountResponse{Address: "host_account", PortId: res.PortId, ChannelId: channelID}, acc)
+
+	send := banktypes.NewMsgSend(other, other, sdk.NewCoins(sdk.NewInt64Coin("unit", 1)))
+	submit := func(o sdk.AccAddress, msgs ...sdk.Msg) error {
+		msg, err := types.NewMsgSubmitTx(o, connectionID, msgs, "memo", 1_000)
+		require.NoError(t, err)
+		_, err = ms.SubmitTx(f.ctx, msg)
+		return err
+	}
+
+	require.NoError(t, submit(contract, send))
+	require.Len(t, f.channel.sent, 1)
+
+	var packetData icatypes.InterchainAccountPacketData
+	require.NoError(t, icatypes.ModuleCdc.UnmarshalJSON(f.channel.sent[0], &packetData))
+	require.Equal(t, "memo", packetData.Memo)
+
+	var tx icatypes.CosmosTx
+	require.NoError(t, f.cdc.Unmarshal(packetData.Data, &tx))
+	require.Len(t, tx.Messages, 1)
+	require.Equal(t, sdk.MsgTypeURL(send), tx.Messages[0].TypeUrl)
+
+	// messages outside the allow-list are rejected
+	err = submit(contract, send, &stakingtypes.MsgCreateValidator{})
+	require.ErrorIs(t, err, types.ErrMsgNotAllowed)
+
+	// accounts that were not registered have no channel
+	err = submit(owner, send)
+	require.ErrorIs(t, err, types.ErrNoActiveChannel)
+
+	require.Len(t, f.channel.sent, 1)
+}
+
+func TestSendPacketPolicy(t *testing.T) {
+	f := setupKeeper(t)
+	_, err := f.k.RegisterInterchainAccount(f.ctx, owner.String(), connectionID, "")
+	require.NoError(t, err)
+
+	packet := func(encoding string, msgs ...sdk.Msg) []byte {
+		data, err := icatypes.SerializeCosmosTx(f.cdc, toProto(msgs), encoding)
+		require.NoError(t, err)
+		return icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}.GetBytes()
+	}
+	send := banktypes.NewMsgSend(other, other, sdk.NewCoins(sdk.NewInt64Coin("unit", 1)))
+	disallowed := &banktypes.MsgMultiSend{}
+
+	sendPacket := func(port string, data []byte) error {
+		_, err := f.k.SendPacket(f.ctx, nil, port, channelID, clienttypes.ZeroHeight(), 1, data)
+		return err
+	}
+
+	// packets sent without the module, e.g. with the controller MsgSendTx,
+	// are checked too
+	require.NoError(t, sendPacket(portID(t, owner), packet(icatypes.EncodingProtobuf, send)))
+	require.ErrorIs(t, sendPacket(portID(t, owner), packet(icatypes.EncodingProtobuf, send, disallowed)), types.ErrMsgNotAllowed)
+
+	f.controller.encoding = icatypes.EncodingProto3JSON
+	require.NoError(t, sendPacket(portID(t, owner), packet(icatypes.EncodingProto3JSON, send)))
+	require.ErrorIs(t, sendPacket(portID(t, owner), packet(icatypes.EncodingProto3JSON, disallowed)), types.ErrMsgNotAllowed)
+
+	// owners lose control when governance removes them
+	params := f.k.GetParams(f.ctx)
+	params.Owners = nil
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+	require.ErrorIs(t, sendPacket(portID(t, owner), packet(icatypes.EncodingProto3JSON, send)), types.ErrUnauthorizedOwner)
+
+	// other ports are not subject to the policy
+	require.NoError(t, sendPacket("transfer", []byte("{}")))
+
+	require.Len(t, f.channel.sent, 3)
+}
+
+func TestSendPacketPolicyNativeController(t *testing.T) {
+	f := setupKeeper(t)
+
+	// accounts registered with the controller MsgRegisterInterchainAccount
+	// do not route their callbacks to the module
+	for _, o := range []sdk.AccAddress{owner, other} {
+		f.controller.ports[portID(t, o)] = false
+	}
+
+	sendTx := func(o sdk.AccAddress, msgs ...sdk.Msg) error {
+		data, err := icatypes.SerializeCosmosTx(f.cdc, toProto(msgs), icatypes.EncodingProtobuf)
+		require.NoError(t, err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+		_, err = f.controller.SendTx(f.ctx, nil, connectionID, portID(t, o), packetData, 1)
+		return err
+	}
+	send := banktypes.NewMsgSend(other, other, sdk.NewCoins(sdk.NewInt64Coin("unit", 1)))
+
+	// the controller MsgSendTx is subject to the same policy
+	require.ErrorIs(t, sendTx(other, send), types.ErrUnauthorizedOwner)
+	require.ErrorIs(t, sendTx(owner, send, &banktypes.MsgMultiSend{}), types.ErrMsgNotAllowed)
+	require.NoError(t, sendTx(owner, send))
+
+	require.Len(t, f.channel.sent, 1)
+}
+
+func toProto(msgs []sdk.Msg) []proto.Message {
+	protoMsgs := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+	}
+	return protoMsgs
+}
+
+func TestCallbacks(t *testing.T) {
+	f := setupKeeper(t)
+	_, err := f.k.RegisterInterchainAccount(f.ctx, contract.String(), connectionID, "")
+	require.NoError(t, err)
+	_, err = f.k.RegisterInterchainAccount(f.ctx, owner.String(), connectionID, "")
+	require.NoError(t, err)
+
+	packet := func(o sdk.AccAddress, sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{Sequence: sequence, SourcePort: portID(t, o), SourceChannel: channelID}
+	}
+	events := func(ctx sdk.Context) []types.EventPacketLifecycleComplete {
+		var res []types.EventPacketLifecycleComplete
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(e)
+			require.NoError(t, err)
+			if ev, ok := msg.(*types.EventPacketLifecycleComplete); ok {
+				res = append(res, *ev)
+			}
+		}
+		return res
+	}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+
+	// contracts are called back with the outcome
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.k.OnAcknowledgementPacket(ctx, packet(contract, 1), successAck))
+	require.NoError(t, f.k.OnTimeoutPacket(ctx, packet(contract, 2)))
+
+	require.Len(t, f.wasm.calls, 2)
+	require.Equal(t, &keeper.ICAAck{ConnectionID: connectionID, Channel: channelID, Sequence: 1, Ack: successAck, Success: true}, f.wasm.calls[0].ICALifecycleComplete.ICAAck)
+	require.Equal(t, &keeper.ICATimeout{ConnectionID: connectionID, Channel: channelID, Sequence: 2}, f.wasm.calls[1].ICALifecycleComplete.ICATimeout)
+	require.True(t, ctx.KVStore(f.key).Has([]byte("callback")))
+	require.Equal(t, []types.EventPacketLifecycleComplete{
+		{Owner: contract.String(), ConnectionId: connectionID, ChannelId: channelID, Sequence: 1, Success: true},
+		{Owner: contract.String(), ConnectionId: connectionID, ChannelId: channelID, Sequence: 2, Timeout: true},
+	}, events(ctx))
+
+	// failing and out of gas callbacks are reverted but do not fail the ack
+	ctx.KVStore(f.key).Delete([]byte("callback"))
+	f.wasm.err = errors.New("boom")
+	ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	require.NoError(t, f.k.OnAcknowledgementPacket(ctx, packet(contract, 3), errorAck))
+	require.False(t, f.wasm.calls[2].ICALifecycleComplete.ICAAck.Success)
+
+	f.wasm.err = nil
+	f.wasm.gasUsed = types.DefaultCallbackGasLimit + 1
+	require.NoError(t, f.k.OnTimeoutPacket(ctx, packet(contract, 4)))
+
+	require.False(t, ctx.KVStore(f.key).Has([]byte("callback")))
+	evs := events(ctx)
+	require.Len(t, evs, 2)
+	require.Equal(t, "boom", evs[0].CallbackError)
+	require.Contains(t, evs[1].CallbackError, "out of gas")
+
+	// other owners only get the event
+	ctx = f.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.k.OnAcknowledgementPacket(ctx, packet(owner, 1), successAck))
+	require.Len(t, f.wasm.calls, 4)
+	require.Equal(t, []types.EventPacketLifecycleComplete{
+		{Owner: owner.String(), ConnectionId: connectionID, ChannelId: channelID, Sequence: 1, Success: true},
+	}, events(ctx))
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	params := types.DefaultParams()
+	params.AllowedMsgTypes = []string{types.AllowAllMsgTypes}
+
+	_, err := ms.UpdateParams(f.ctx, types.NewMsgUpdateParams(other, params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.True(t, f.k.GetParams(f.ctx).IsAllowedMsgType("/any.Msg"))
+
+	params.Owners = []string{owner.String(), owner.String()}
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+}
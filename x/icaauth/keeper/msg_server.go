@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams replaces the module params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterInterchainAccount opens an interchain account channel for the owner.
+func (ms msgServer) RegisterInterchainAccount(ctx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	portID, err := ms.k.RegisterInterchainAccount(sdk.UnwrapSDKContext(ctx), msg.Owner, msg.ConnectionId, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
+
+// SubmitTx sends messages to the interchain account of the owner.
+func (ms msgServer) SubmitTx(ctx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := ms.k.SubmitTx(sdk.UnwrapSDKContext(ctx), msg.Owner, msg.ConnectionId, msgs, msg.Memo, msg.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitTxResponse{Sequence: sequence}, nil
+}
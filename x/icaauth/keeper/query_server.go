@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/icaauth/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// InterchainAccount returns the interchain account of an owner.
+func (k Querier) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	address, portID, channelID, err := k.Keeper.InterchainAccount(sdk.UnwrapSDKContext(c), req.Owner, req.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountResponse{Address: address, PortId: portID, ChannelId: channelID}, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrUnauthorizedOwner = errorsmod.Register(ModuleName, 2, "owner may not control interchain accounts")
+	ErrMsgNotAllowed     = errorsmod.Register(ModuleName, 3, "message type not allowed on interchain accounts")
+	ErrNoActiveChannel   = errorsmod.Register(ModuleName, 4, "no active interchain account channel")
+)
@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icaauth/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPacketLifecycleComplete is emitted when a packet sent by an interchain
+// account of this module is acknowledged or times out.
+type EventPacketLifecycleComplete struct {
+	// owner is the owner of the interchain account.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// connection_id is the connection to the host chain.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel_id is the channel the packet was sent on.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// success is true if the host chain executed the messages.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// timeout is true if the packet timed out.
+	Timeout bool `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// callback_error is the error returned by the owner contract callback, if
+	// any. A failed callback does not revert the acknowledgement.
+	CallbackError string `protobuf:"bytes,7,opt,name=callback_error,json=callbackError,proto3" json:"callback_error,omitempty"`
+}
+
+func (m *EventPacketLifecycleComplete) Reset()         { *m = EventPacketLifecycleComplete{} }
+func (m *EventPacketLifecycleComplete) String() string { return proto.CompactTextString(m) }
+func (*EventPacketLifecycleComplete) ProtoMessage()    {}
+func (*EventPacketLifecycleComplete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94c056c00df85763, []int{0}
+}
+func (m *EventPacketLifecycleComplete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPacketLifecycleComplete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPacketLifecycleComplete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPacketLifecycleComplete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPacketLifecycleComplete.Merge(m, src)
+}
+func (m *EventPacketLifecycleComplete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPacketLifecycleComplete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPacketLifecycleComplete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPacketLifecycleComplete proto.InternalMessageInfo
+
+func (m *EventPacketLifecycleComplete) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventPacketLifecycleComplete) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *EventPacketLifecycleComplete) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *EventPacketLifecycleComplete) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventPacketLifecycleComplete) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EventPacketLifecycleComplete) GetTimeout() bool {
+	if m != nil {
+		return m.Timeout
+	}
+	return false
+}
+
+func (m *EventPacketLifecycleComplete) GetCallbackError() string {
+	if m != nil {
+		return m.CallbackError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventPacketLifecycleComplete)(nil), "icaauth.v1.EventPacketLifecycleComplete")
+}
+
+func init() { proto.RegisterFile("icaauth/v1/events.proto", fileDescriptor_94c056c00df85763) }
+
+var fileDescriptor_94c056c00df85763 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xcd, 0x4a, 0x33, 0x31,
+	0x14, 0x86, 0x9b, 0x7e, 0xfd, 0x0d, 0x5f, 0x5d, 0x04, 0xc1, 0x58, 0x74, 0x28, 0x8a, 0x50, 0x90,
+	0xce, 0x50, 0xbc, 0x02, 0x2b, 0x5d, 0x14, 0x5c, 0xc8, 0xb8, 0x73, 0x53, 0x66, 0xce, 0x1c, 0xdb,
+	0xd0, 0x99, 0xa4, 0x26, 0x99, 0x6a, 0x2f, 0xc1, 0x9d, 0x17, 0xe3, 0x45, 0xb8, 0x2c, 0xae, 0x5c,
+	0x4a, 0x7b, 0x23, 0x32, 0x3f, 0xd5, 0x4d, 0xe0, 0x7d, 0x9f, 0xe7, 0x24, 0x90, 0x43, 0x8f, 0x04,
+	0x04, 0x41, 0x6a, 0xe7, 0xde, 0x6a, 0xe8, 0xe1, 0x0a, 0xa5, 0x35, 0xee, 0x52, 0x2b, 0xab, 0x18,
+	0x2d, 0x81, 0xbb, 0x1a, 0x76, 0x8f, 0x41, 0x99, 0x44, 0x99, 0x69, 0x4e, 0xbc, 0x22, 0x14, 0xda,
+	0xd9, 0x6b, 0x95, 0x9e, 0x8c, 0xb3, 0xb9, 0xbb, 0x00, 0x16, 0x68, 0x6f, 0xc5, 0x23, 0xc2, 0x1a,
+	0x62, 0xbc, 0x51, 0xc9, 0x32, 0x46, 0x8b, 0xcc, 0xa5, 0x75, 0xf5, 0x2c, 0x51, 0x73, 0xd2, 0x23,
+	0xfd, 0xf6, 0x88, 0x7f, 0xbe, 0x0f, 0x0e, 0xcb, 0x1b, 0xae, 0xa3, 0x48, 0xa3, 0x31, 0xf7, 0x56,
+	0x0b, 0x39, 0xf3, 0x0b, 0x8d, 0x9d, 0xd3, 0x0e, 0x28, 0x29, 0x11, 0xac, 0x50, 0x72, 0x2a, 0x22,
+	0x5e, 0xcd, 0xe6, 0xfc, 0xff, 0x7f, 0xe5, 0x24, 0x62, 0xa7, 0x94, 0xc2, 0x3c, 0x90, 0x12, 0xe3,
+	0xcc, 0xf8, 0x97, 0x1b, 0xed, 0xb2, 0x99, 0x44, 0xac, 0x4b, 0x5b, 0x06, 0x9f, 0x52, 0x94, 0x80,
+	0xbc, 0xd6, 0x23, 0xfd, 0x9a, 0xff, 0x9b, 0x19, 0xa7, 0x4d, 0x93, 0x02, 0xa0, 0x31, 0xbc, 0xde,
+	0x23, 0xfd, 0x96, 0xbf, 0x8f, 0x19, 0xb1, 0x22, 0x41, 0x95, 0x5a, 0xde, 0x28, 0x48, 0x19, 0xd9,
+	0x05, 0x3d, 0x80, 0x20, 0x8e, 0xc3, 0x00, 0x16, 0x53, 0xd4, 0x5a, 0x69, 0xde, 0xcc, 0x9f, 0xec,
+	0xec, 0xdb, 0x71, 0x56, 0x8e, 0xc6, 0x1f, 0x5b, 0x87, 0x6c, 0xb6, 0x0e, 0xf9, 0xde, 0x3a, 0xe4,
+	0x6d, 0xe7, 0x54, 0x36, 0x3b, 0xa7, 0xf2, 0xb5, 0x73, 0x2a, 0x0f, 0x97, 0x33, 0x61, 0xe7, 0x69,
+	0xe8, 0x82, 0x4a, 0x3c, 0x95, 0xda, 0x10, 0x8b, 0x73, 0x20, 0x55, 0x84, 0xde, 0x8b, 0xb7, 0xdf,
+	0x81, 0x5d, 0x2f, 0xd1, 0x84, 0x8d, 0xfc, 0x67, 0xaf, 0x7e, 0x06, 0x00, 0xd8, 0x53, 0x2a, 0xb1,
+	0x9b, 0x01, 0x00, 0x00,
+}
+
+func (m *EventPacketLifecycleComplete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPacketLifecycleComplete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPacketLifecycleComplete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackError) > 0 {
+		i -= len(m.CallbackError)
+		copy(dAtA[i:], m.CallbackError)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CallbackError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Timeout {
+		i--
+		if m.Timeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPacketLifecycleComplete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Timeout {
+		n += 2
+	}
+	l = len(m.CallbackError)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPacketLifecycleComplete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPacketLifecycleComplete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPacketLifecycleComplete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+// ICAControllerKeeper defines the ICA controller methods used to register and
+// operate interchain accounts on behalf of their owners.
+type ICAControllerKeeper interface {
+	RegisterInterchainAccount(ctx sdk.Context, connectionID, owner, version string) error
+	SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error)
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetOpenActiveChannel(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool)
+	GetConnectionID(ctx sdk.Context, portID, channelID string) (string, error)
+	IsMiddlewareEnabled(ctx sdk.Context, portID, connectionID string) bool
+}
+
+// GroupKeeper defines the group methods used to recognise group policy
+// accounts.
+type GroupKeeper interface {
+	GroupPolicyInfo(ctx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+}
+
+// ContractKeeper defines the wasm methods used to call owner contracts back.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractViewKeeper defines the wasm methods used to recognise contracts.
+type ContractViewKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
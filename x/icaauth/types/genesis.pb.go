@@ -0,0 +1,688 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icaauth/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the icaauth module genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ebfc8327b166956, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the parameters of the icaauth module.
+type Params struct {
+	// allow_contracts lets any wasm contract own interchain accounts.
+	AllowContracts bool `protobuf:"varint,1,opt,name=allow_contracts,json=allowContracts,proto3" json:"allow_contracts,omitempty"`
+	// allow_group_policies lets any group policy account own interchain
+	// accounts.
+	AllowGroupPolicies bool `protobuf:"varint,2,opt,name=allow_group_policies,json=allowGroupPolicies,proto3" json:"allow_group_policies,omitempty"`
+	// owners are the additional addresses approved by governance to own
+	// interchain accounts.
+	Owners []string `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	// allowed_msg_types are the type URLs of the messages interchain accounts
+	// may execute on the host chain. "*" allows every message.
+	AllowedMsgTypes []string `protobuf:"bytes,4,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// callback_gas_limit is the maximum amount of gas an owner contract may
+	// consume when called back on the acknowledgement or timeout of a packet.
+	CallbackGasLimit uint64 `protobuf:"varint,5,opt,name=callback_gas_limit,json=callbackGasLimit,proto3" json:"callback_gas_limit,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ebfc8327b166956, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowContracts() bool {
+	if m != nil {
+		return m.AllowContracts
+	}
+	return false
+}
+
+func (m *Params) GetAllowGroupPolicies() bool {
+	if m != nil {
+		return m.AllowGroupPolicies
+	}
+	return false
+}
+
+func (m *Params) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.CallbackGasLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "icaauth.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "icaauth.v1.Params")
+}
+
+func init() { proto.RegisterFile("icaauth/v1/genesis.proto", fileDescriptor_2ebfc8327b166956) }
+
+var fileDescriptor_2ebfc8327b166956 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0x3f, 0x4f, 0xf2, 0x40,
+	0x1c, 0xc7, 0x7b, 0x0f, 0x3c, 0x8d, 0x9c, 0x06, 0xe4, 0x24, 0xa6, 0x61, 0xa8, 0x84, 0x45, 0x82,
+	0xda, 0x8a, 0xc6, 0xc5, 0x51, 0x43, 0x58, 0x34, 0x21, 0xd5, 0xc9, 0xa5, 0xb9, 0x96, 0xcb, 0x71,
+	0xb1, 0xed, 0x35, 0xbd, 0x2b, 0xe8, 0x5b, 0x70, 0xf2, 0x65, 0x38, 0xf2, 0x32, 0x18, 0x19, 0x9d,
+	0x8c, 0x81, 0x81, 0xc4, 0x57, 0x61, 0x7a, 0x6d, 0xe3, 0xf2, 0xcb, 0xdd, 0xf7, 0xf3, 0xc9, 0x37,
+	0xf7, 0x07, 0x1a, 0xcc, 0xc7, 0x38, 0x95, 0x53, 0x7b, 0x36, 0xb0, 0x29, 0x89, 0x88, 0x60, 0xc2,
+	0x8a, 0x13, 0x2e, 0x39, 0x82, 0x05, 0xb1, 0x66, 0x83, 0x76, 0x8b, 0x72, 0xca, 0x55, 0x6c, 0x67,
+	0xab, 0xdc, 0x68, 0x37, 0x71, 0xc8, 0x22, 0x6e, 0xab, 0x99, 0x47, 0xdd, 0x21, 0xdc, 0x1b, 0xe5,
+	0x2d, 0x0f, 0x12, 0x4b, 0x82, 0xae, 0xa0, 0x1e, 0xe3, 0x04, 0x87, 0xc2, 0x00, 0x1d, 0xd0, 0xdb,
+	0xbd, 0x40, 0xd6, 0x5f, 0xab, 0x35, 0x56, 0xe4, 0xa6, 0xb6, 0xfc, 0x3a, 0xd2, 0x3e, 0xb6, 0x8b,
+	0x3e, 0x70, 0x0a, 0xb9, 0xfb, 0x03, 0xa0, 0x9e, 0x53, 0x74, 0x0c, 0x1b, 0x38, 0x08, 0xf8, 0xdc,
+	0xf5, 0x79, 0x24, 0x13, 0xec, 0xcb, 0xbc, 0x6a, 0xc7, 0xa9, 0xab, 0xf8, 0xb6, 0x4c, 0xd1, 0x39,
+	0x6c, 0xe5, 0x22, 0x4d, 0x78, 0x1a, 0xbb, 0x31, 0x0f, 0x98, 0xcf, 0x88, 0x30, 0xfe, 0x29, 0x1b,
+	0x29, 0x36, 0xca, 0xd0, 0xb8, 0x20, 0xe8, 0x10, 0xea, 0x7c, 0x1e, 0x91, 0x44, 0x18, 0x95, 0x4e,
+	0xa5, 0x57, 0x73, 0x8a, 0x1d, 0xea, 0xc3, 0xa6, 0xb2, 0xc9, 0xc4, 0x0d, 0x05, 0x75, 0xe5, 0x6b,
+	0x4c, 0x84, 0x51, 0x55, 0x4a, 0xa3, 0x00, 0xf7, 0x82, 0x3e, 0x66, 0x31, 0x3a, 0x85, 0xc8, 0xc7,
+	0x41, 0xe0, 0x61, 0xff, 0xd9, 0xa5, 0x58, 0xb8, 0x01, 0x0b, 0x99, 0x34, 0xfe, 0x77, 0x40, 0xaf,
+	0xea, 0xec, 0x97, 0x64, 0x84, 0xc5, 0x5d, 0x96, 0x5f, 0x1f, 0xbc, 0x6d, 0x17, 0xfd, 0x7a, 0xf9,
+	0xe4, 0xc5, 0xfd, 0x87, 0xcb, 0xb5, 0x09, 0x56, 0x6b, 0x13, 0x7c, 0xaf, 0x4d, 0xf0, 0xbe, 0x31,
+	0xb5, 0xd5, 0xc6, 0xd4, 0x3e, 0x37, 0xa6, 0xf6, 0x74, 0x42, 0x99, 0x9c, 0xa6, 0x9e, 0xe5, 0xf3,
+	0xd0, 0xe6, 0xa9, 0xf4, 0x48, 0x3e, 0xcf, 0x22, 0x3e, 0x21, 0xf6, 0x8b, 0x5d, 0xf6, 0xa8, 0x03,
+	0x7a, 0xba, 0xfa, 0x81, 0xcb, 0xdf, 0x01, 0x00, 0x84, 0xea, 0x01, 0xf8, 0xd2, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallbackGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CallbackGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AllowGroupPolicies {
+		i--
+		if m.AllowGroupPolicies {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowContracts {
+		i--
+		if m.AllowContracts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowContracts {
+		n += 2
+	}
+	if m.AllowGroupPolicies {
+		n += 2
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CallbackGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.CallbackGasLimit))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowContracts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowContracts = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGroupPolicies", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGroupPolicies = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimit", wireType)
+			}
+			m.CallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
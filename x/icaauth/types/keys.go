@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+// ParamsKey saves the current module params.
+var ParamsKey = collections.NewPrefix(0)
+
+const (
+	ModuleName = "icaauth"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitTx{}
+)
+
+// NewMsgUpdateParams creates new instance of MsgUpdateParams
+func NewMsgUpdateParams(
+	sender sdk.Address,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: sender.String(),
+		Params:    params,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgRegisterInterchainAccount creates new instance of MsgRegisterInterchainAccount
+func NewMsgRegisterInterchainAccount(owner sdk.Address, connectionID, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterInterchainAccount) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgRegisterInterchainAccount) Type() string { return "register_interchain_account" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterInterchainAccount message.
+func (msg *MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRegisterInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "invalid owner address")
+	}
+
+	return host.ConnectionIdentifierValidator(msg.ConnectionId)
+}
+
+// NewMsgSubmitTx creates new instance of MsgSubmitTx
+func NewMsgSubmitTx(owner sdk.Address, connectionID string, msgs []sdk.Msg, memo string, relativeTimeout uint64) (*MsgSubmitTx, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, m := range msgs {
+		a, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = a
+	}
+
+	return &MsgSubmitTx{
+		Owner:           owner.String(),
+		ConnectionId:    connectionID,
+		Messages:        anys,
+		Memo:            memo,
+		RelativeTimeout: relativeTimeout,
+	}, nil
+}
+
+// Route returns the name of the module
+func (msg MsgSubmitTx) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgSubmitTx) Type() string { return "submit_tx" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitTx message.
+func (msg *MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "invalid owner address")
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	if len(msg.Messages) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "no messages")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "relative timeout must be positive")
+	}
+
+	return nil
+}
+
+// GetMsgs returns the unpacked messages executed by the interchain account.
+func (msg MsgSubmitTx) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Messages))
+	for i, a := range msg.Messages {
+		m, ok := a.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not an sdk.Msg: %s", i, a.TypeUrl)
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range msg.Messages {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(a, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowAllMsgTypes is the allowed_msg_types entry that allows every
+	// message, like the allow_messages param of the ICA host.
+	AllowAllMsgTypes = "*"
+
+	// DefaultCallbackGasLimit is the default gas budget of a single owner
+	// contract callback.
+	DefaultCallbackGasLimit uint64 = 500_000
+)
+
+// DefaultAllowedMsgTypes returns the messages interchain accounts may execute
+// by default: transfers, staking and governance votes.
+func DefaultAllowedMsgTypes() []string {
+	return []string{
+		"/cosmos.bank.v1beta1.MsgSend",
+		"/cosmos.staking.v1beta1.MsgDelegate",
+		"/cosmos.staking.v1beta1.MsgUndelegate",
+		"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+		"/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward",
+		"/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.gov.v1.MsgVote",
+		"/ibc.applications.transfer.v1.MsgTransfer",
+	}
+}
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return Params{
+		AllowContracts:     true,
+		AllowGroupPolicies: true,
+		AllowedMsgTypes:    DefaultAllowedMsgTypes(),
+		CallbackGasLimit:   DefaultCallbackGasLimit,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(allowContracts, allowGroupPolicies bool, owners, allowedMsgTypes []string, callbackGasLimit uint64) Params {
+	return Params{
+		AllowContracts:     allowContracts,
+		AllowGroupPolicies: allowGroupPolicies,
+		Owners:             owners,
+		AllowedMsgTypes:    allowedMsgTypes,
+		CallbackGasLimit:   callbackGasLimit,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Owners))
+	for _, owner := range p.Owners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid owner %s: %s", owner, err)
+		}
+		if seen[owner] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate owner %s", owner)
+		}
+		seen[owner] = true
+	}
+
+	seen = make(map[string]bool, len(p.AllowedMsgTypes))
+	for _, typeURL := range p.AllowedMsgTypes {
+		if typeURL == "" {
+			return errorsmod.Wrap(ErrInvalidParams, "empty allowed msg type")
+		}
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate allowed msg type %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	if p.CallbackGasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "callback gas limit must be positive")
+	}
+
+	return nil
+}
+
+// IsOwner reports whether addr was approved as an owner by governance.
+func (p Params) IsOwner(addr string) bool {
+	return slices.Contains(p.Owners, addr)
+}
+
+// IsAllowedMsgType reports whether interchain accounts may execute messages
+// of typeURL.
+func (p Params) IsAllowedMsgType(typeURL string) bool {
+	return slices.Contains(p.AllowedMsgTypes, AllowAllMsgTypes) || slices.Contains(p.AllowedMsgTypes, typeURL)
+}
@@ -0,0 +1,42 @@
+package icahostpolicy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "icahostpolicy.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current icahostpolicy parameters",
+				},
+				{
+					RpcMethod:      "ConnectionPolicy",
+					Use:            "connection-policy [connection-id]",
+					Short:          "Query the policy applied to interchain accounts of a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod:      "AccountSpend",
+					Use:            "account-spend [address]",
+					Short:          "Query the spend of an interchain account in its current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "icahostpolicy.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+			},
+		},
+	}
+}
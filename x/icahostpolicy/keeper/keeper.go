@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/outbe/outbe-node/x/icahostpolicy/types"
+)
+
+type Keeper struct {
+	cdc codec.Codec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	Spends collections.Map[sdk.AccAddress, types.AccountSpend]
+
+	hostKeeper       types.ICAHostKeeper
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+	clientKeeper     types.ClientKeeper
+	bankKeeper       types.BankKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	hostKeeper types.ICAHostKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Spends: collections.NewMap(sb, types.SpendsKey, "spends", sdk.AccAddressKey, codec.CollValue[types.AccountSpend](cdc)),
+
+		hostKeeper:       hostKeeper,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		clientKeeper:     clientKeeper,
+		bankKeeper:       bankKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// ConnectionPolicy returns the policy applied to connectionID, where it comes
+// from and the chain ID of the controller chain.
+func (k Keeper) ConnectionPolicy(ctx sdk.Context, connectionID string) (types.HostPolicy, string, string) {
+	chainID := k.controllerChainID(ctx, connectionID)
+	policy, source := k.GetParams(ctx).Policy(connectionID, chainID)
+
+	return policy, source, chainID
+}
+
+// controllerChainID returns the chain ID tracked by the light client of the
+// connection, or an empty string for clients that do not track one.
+func (k Keeper) controllerChainID(ctx sdk.Context, connectionID string) string {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return ""
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return ""
+	}
+
+	if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+		return tmClientState.ChainId
+	}
+
+	return ""
+}
+
+// GetSpend returns the spend of an interchain account in its current window.
+// Expired windows are returned empty.
+func (k Keeper) GetSpend(ctx sdk.Context, addr sdk.AccAddress) (types.AccountSpend, error) {
+	spend, err := k.Spends.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && spend.WindowStart+k.GetParams(ctx).SpendWindowBlocks <= ctx.BlockHeight()) {
+		return types.AccountSpend{Address: addr.String(), WindowStart: ctx.BlockHeight(), Spent: sdk.Coins{}}, nil
+	}
+
+	return spend, err
+}
+
+// GetAllSpends returns every stored spend ordered by address.
+func (k Keeper) GetAllSpends(ctx context.Context) ([]types.AccountSpend, error) {
+	var spends []types.AccountSpend
+	err := k.Spends.Walk(ctx, nil, func(_ sdk.AccAddress, spend types.AccountSpend) (bool, error) {
+		spends = append(spends, spend)
+		return false, nil
+	})
+
+	return spends, err
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, s := range data.Spends {
+		if err := k.Spends.Set(ctx, sdk.MustAccAddressFromBech32(s.Address), s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	spends, err := k.GetAllSpends(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), spends...)
+}
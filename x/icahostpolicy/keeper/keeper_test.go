@@ -0,0 +1,307 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/outbe/outbe-node/x/icahostpolicy/keeper"
+	"github.com/outbe/outbe-node/x/icahostpolicy/types"
+)
+
+const (
+	connectionID = "connection-0"
+	clientID     = "07-tendermint-0"
+	channelID    = "channel-0"
+	chainID      = "controller-1"
+)
+
+var (
+	hostAccount    = sdk.AccAddress([]byte("host_account________"))
+	other          = sdk.AccAddress([]byte("other_address_______"))
+	controllerPort = "icacontroller-owner"
+)
+
+// mockIBC resolves channelID to connectionID, whose client tracks chainID,
+// and controllerPort to hostAccount.
+type mockIBC struct {
+	encoding string
+}
+
+func (m mockIBC) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	md := icatypes.NewMetadata(icatypes.Version, connectionID, connectionID, hostAccount.String(), m.encoding, icatypes.TxTypeSDKMultiMsg)
+	return string(icatypes.ModuleCdc.MustMarshalJSON(&md)), true
+}
+
+func (mockIBC) GetInterchainAccountAddress(_ sdk.Context, connID, portID string) (string, bool) {
+	if connID != connectionID || portID != controllerPort {
+		return "", false
+	}
+	return hostAccount.String(), true
+}
+
+func (mockIBC) GetChannel(_ sdk.Context, _, chanID string) (channeltypes.Channel, bool) {
+	if chanID != channelID {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{ConnectionHops: []string{connectionID}}, true
+}
+
+func (mockIBC) GetConnection(_ sdk.Context, connID string) (connectiontypes.ConnectionEnd, bool) {
+	if connID != connectionID {
+		return connectiontypes.ConnectionEnd{}, false
+	}
+	return connectiontypes.ConnectionEnd{ClientId: clientID}, true
+}
+
+func (mockIBC) GetClientState(_ sdk.Context, id string) (ibcexported.ClientState, bool) {
+	if id != clientID {
+		return nil, false
+	}
+	return &ibctm.ClientState{ChainId: chainID}, true
+}
+
+// mockBank holds the balances the packet execution spends from.
+type mockBank struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBank) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+type fixture struct {
+	ctx  sdk.Context
+	cdc  codec.Codec
+	k    keeper.Keeper
+	bank *mockBank
+}
+
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+
+	f := fixture{
+		ctx:  testCtx.Ctx.WithBlockHeight(10),
+		cdc:  encCfg.Codec,
+		bank: &mockBank{balances: map[string]sdk.Coins{}},
+	}
+
+	ibc := mockIBC{encoding: icatypes.EncodingProtobuf}
+	f.k = keeper.NewKeeper(
+		f.cdc,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		ibc,
+		ibc,
+		ibc,
+		ibc,
+		f.bank,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return f
+}
+
+// packet builds an ICA packet executing msgs on channelID.
+func (f fixture) packet(t *testing.T, msgs ...sdk.Msg) channeltypes.Packet {
+	t.Helper()
+
+	data, err := icatypes.SerializeCosmosTx(f.cdc, msgs, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	return channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         controllerPort,
+		SourceChannel:      channelID,
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: channelID,
+		Data:               packetData.GetBytes(),
+	}
+}
+
+// spend returns a packet execution that sends amount from the host account.
+func (f fixture) spend(amount sdk.Coins) func() ibcexported.Acknowledgement {
+	return func() ibcexported.Acknowledgement {
+		addr := hostAccount.String()
+		f.bank.balances[addr] = f.bank.balances[addr].Sub(amount...)
+		return channeltypes.NewResultAcknowledgement([]byte{1})
+	}
+}
+
+func TestConnectionPolicy(t *testing.T) {
+	f := setupKeeper(t)
+	q := keeper.NewQuerier(f.k)
+
+	connPolicy := types.NewHostPolicy([]string{"/conn.Msg"}, 1, nil)
+	chainPolicy := types.NewHostPolicy([]string{"/chain.Msg"}, 2, nil)
+
+	res, err := q.ConnectionPolicy(f.ctx, &types.QueryConnectionPolicyRequest{ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConnectionPolicyResponse{Policy: types.DefaultParams().DefaultPolicy, Source: types.PolicySourceDefault, ChainId: chainID}, res)
+
+	// a chain policy applies to every connection to the chain
+	params := types.DefaultParams()
+	params.ChainPolicies = []types.ChainPolicy{{ChainId: chainID, Policy: chainPolicy}}
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	res, err = q.ConnectionPolicy(f.ctx, &types.QueryConnectionPolicyRequest{ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConnectionPolicyResponse{Policy: chainPolicy, Source: types.PolicySourceChain, ChainId: chainID}, res)
+
+	// a connection policy takes precedence over it
+	params.ConnectionPolicies = []types.ConnectionPolicy{{ConnectionId: connectionID, Policy: connPolicy}}
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	res, err = q.ConnectionPolicy(f.ctx, &types.QueryConnectionPolicyRequest{ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConnectionPolicyResponse{Policy: connPolicy, Source: types.PolicySourceConnection, ChainId: chainID}, res)
+
+	// unknown connections have no chain and get the default policy
+	res, err = q.ConnectionPolicy(f.ctx, &types.QueryConnectionPolicyRequest{ConnectionId: "connection-7"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConnectionPolicyResponse{Policy: params.DefaultPolicy, Source: types.PolicySourceDefault}, res)
+}
+
+func TestOnRecvPacketMsgs(t *testing.T) {
+	f := setupKeeper(t)
+
+	send := banktypes.NewMsgSend(hostAccount, other, sdk.NewCoins(sdk.NewInt64Coin("unit", 1)))
+	multiSend := banktypes.NewMsgMultiSend(banktypes.NewInput(hostAccount, send.Amount), []banktypes.Output{banktypes.NewOutput(other, send.Amount)})
+
+	params := types.DefaultParams()
+	params.ConnectionPolicies = []types.ConnectionPolicy{{
+		ConnectionId: connectionID,
+		Policy:       types.NewHostPolicy([]string{sdk.MsgTypeURL(send)}, 2, nil),
+	}}
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	executed := 0
+	next := func() ibcexported.Acknowledgement {
+		executed++
+		return channeltypes.NewResultAcknowledgement([]byte{1})
+	}
+
+	ack := f.k.OnRecvPacket(f.ctx, f.packet(t, send, send), next)
+	require.True(t, ack.Success())
+	require.Equal(t, 1, executed)
+
+	ack = f.k.OnRecvPacket(f.ctx, f.packet(t, send, multiSend), next)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, executed)
+
+	ack = f.k.OnRecvPacket(f.ctx, f.packet(t, send, send, send), next)
+	require.False(t, ack.Success())
+	require.Equal(t, 1, executed)
+
+	var rejected int
+	for _, e := range f.ctx.EventManager().Events() {
+		if e.Type == "icahostpolicy.v1.EventPacketRejected" {
+			rejected++
+		}
+	}
+	require.Equal(t, 2, rejected)
+
+	// packets the host cannot decode are left to the host
+	packet := f.packet(t, send)
+	packet.Data = []byte("junk")
+	require.True(t, f.k.OnRecvPacket(f.ctx, packet, next).Success())
+	require.Equal(t, 2, executed)
+}
+
+func TestOnRecvPacketSpendLimit(t *testing.T) {
+	f := setupKeeper(t)
+	q := keeper.NewQuerier(f.k)
+
+	f.bank.balances[hostAccount.String()] = sdk.NewCoins(sdk.NewInt64Coin("unit", 1_000), sdk.NewInt64Coin("other", 1_000))
+
+	params := types.DefaultParams()
+	params.DefaultPolicy.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("unit", 100))
+	params.SpendWindowBlocks = 10
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	send := banktypes.NewMsgSend(hostAccount, other, sdk.NewCoins(sdk.NewInt64Coin("unit", 60)))
+	packet := f.packet(t, send)
+
+	require.True(t, f.k.OnRecvPacket(f.ctx, packet, f.spend(send.Amount)).Success())
+
+	res, err := q.AccountSpend(f.ctx, &types.QueryAccountSpendRequest{Address: hostAccount.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.AccountSpend{Address: hostAccount.String(), WindowStart: 10, Spent: send.Amount}, res.Spend)
+
+	// denoms without a limit are not accounted
+	require.True(t, f.k.OnRecvPacket(f.ctx, packet, f.spend(sdk.NewCoins(sdk.NewInt64Coin("other", 500)))).Success())
+
+	// the second spend of 60 goes over the limit of the window
+	require.False(t, f.k.OnRecvPacket(f.ctx.WithBlockHeight(19), packet, f.spend(send.Amount)).Success())
+
+	res, err = q.AccountSpend(f.ctx.WithBlockHeight(19), &types.QueryAccountSpendRequest{Address: hostAccount.String()})
+	require.NoError(t, err)
+	require.Equal(t, send.Amount, res.Spend.Spent)
+
+	// a new window starts once the previous one is over
+	ctx := f.ctx.WithBlockHeight(20)
+	require.True(t, f.k.OnRecvPacket(ctx, packet, f.spend(send.Amount)).Success())
+
+	res, err = q.AccountSpend(ctx, &types.QueryAccountSpendRequest{Address: hostAccount.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.AccountSpend{Address: hostAccount.String(), WindowStart: 20, Spent: send.Amount}, res.Spend)
+
+	gs := f.k.ExportGenesis(ctx)
+	require.NoError(t, gs.Validate())
+	require.Len(t, gs.Spends, 1)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	params := types.DefaultParams()
+	params.ChainPolicies = []types.ChainPolicy{{ChainId: chainID, Policy: types.NewHostPolicy(nil, 1, nil)}}
+
+	_, err := ms.UpdateParams(f.ctx, types.NewMsgUpdateParams(other, params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params.ChainPolicies, f.k.GetParams(f.ctx).ChainPolicies)
+
+	for _, invalid := range []func(p *types.Params){
+		func(p *types.Params) { p.SpendWindowBlocks = 0 },
+		func(p *types.Params) { p.DefaultPolicy.AllowedMsgTypes = []string{""} },
+		func(p *types.Params) { p.ChainPolicies = append(p.ChainPolicies, p.ChainPolicies[0]) },
+		func(p *types.Params) {
+			p.ConnectionPolicies = []types.ConnectionPolicy{{ConnectionId: "invalid id", Policy: p.DefaultPolicy}}
+		},
+	} {
+		p := types.DefaultParams()
+		p.ChainPolicies = params.ChainPolicies
+		invalid(&p)
+
+		_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: p})
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	}
+}
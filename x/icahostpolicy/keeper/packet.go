@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/outbe/outbe-node/x/icahostpolicy/types"
+)
+
+// OnRecvPacket enforces the policy of the connection on a packet received by
+// the ICA host and calls next to execute it. The spend of the interchain
+// account is measured around next; a packet over the spend limit is turned
+// into an error acknowledgement, which reverts its execution.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, next func() ibcexported.Acknowledgement) ibcexported.Acknowledgement {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return next()
+	}
+	connectionID := channel.ConnectionHops[0]
+
+	policy, _, _ := k.ConnectionPolicy(ctx, connectionID)
+
+	msgs, ok := k.packetMsgs(ctx, packet)
+	if !ok {
+		// the host rejects packets it cannot decode with its own error
+		return next()
+	}
+
+	if err := checkMsgs(policy, msgs); err != nil {
+		return k.rejectPacket(ctx, packet, connectionID, err)
+	}
+
+	if policy.SpendLimit.Empty() {
+		return next()
+	}
+
+	address, found := k.hostKeeper.GetInterchainAccountAddress(ctx, connectionID, packet.SourcePort)
+	if !found {
+		return next()
+	}
+	account, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return next()
+	}
+
+	before := k.bankKeeper.GetAllBalances(ctx, account)
+
+	ack := next()
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := k.consumeSpend(ctx, account, policy.SpendLimit, before); err != nil {
+		return k.rejectPacket(ctx, packet, connectionID, err)
+	}
+
+	return ack
+}
+
+// packetMsgs decodes the messages of an ICA packet like the host does.
+func (k Keeper) packetMsgs(ctx sdk.Context, packet channeltypes.Packet) ([]sdk.Msg, bool) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil || packetData.Type != icatypes.EXECUTE_TX {
+		return nil, false
+	}
+
+	version, found := k.hostKeeper.GetAppVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return nil, false
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return nil, false
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, packetData.Data, metadata.Encoding)
+	if err != nil {
+		return nil, false
+	}
+
+	return msgs, true
+}
+
+// checkMsgs checks the number and types of the messages of a packet.
+func checkMsgs(policy types.HostPolicy, msgs []sdk.Msg) error {
+	if policy.MaxMsgs > 0 && len(msgs) > int(policy.MaxMsgs) {
+		return errorsmod.Wrapf(types.ErrTooManyMsgs, "%d messages, limit %d", len(msgs), policy.MaxMsgs)
+	}
+
+	for _, msg := range msgs {
+		if typeURL := sdk.MsgTypeURL(msg); !policy.IsAllowedMsgType(typeURL) {
+			return errorsmod.Wrap(types.ErrMsgNotAllowed, typeURL)
+		}
+	}
+
+	return nil
+}
+
+// consumeSpend adds the decrease of the balance of account since before to
+// its spend and fails if that exceeds limit.
+func (k Keeper) consumeSpend(ctx sdk.Context, account sdk.AccAddress, limit, before sdk.Coins) error {
+	after := k.bankKeeper.GetAllBalances(ctx, account)
+
+	spent := sdk.NewCoins()
+	for _, coin := range limit {
+		if decrease := before.AmountOf(coin.Denom).Sub(after.AmountOf(coin.Denom)); decrease.IsPositive() {
+			spent = spent.Add(sdk.NewCoin(coin.Denom, decrease))
+		}
+	}
+
+	if spent.Empty() {
+		return nil
+	}
+
+	spend, err := k.GetSpend(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	spend.Spent = spend.Spent.Add(spent...)
+	if !spend.Spent.IsAllLTE(limit) {
+		return errorsmod.Wrapf(types.ErrSpendLimitReached, "spent %s since height %d, limit %s", spend.Spent, spend.WindowStart, limit)
+	}
+
+	return k.Spends.Set(ctx, account, spend)
+}
+
+// rejectPacket emits the reason of the rejection, which the error
+// acknowledgement does not carry.
+func (k Keeper) rejectPacket(ctx sdk.Context, packet channeltypes.Packet, connectionID string, err error) ibcexported.Acknowledgement {
+	k.Logger().Info("interchain account packet rejected", "connection", connectionID, "channel", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+
+	if emitErr := ctx.EventManager().EmitTypedEvent(&types.EventPacketRejected{
+		ConnectionId: connectionID,
+		ChannelId:    packet.DestinationChannel,
+		Sequence:     packet.Sequence,
+		Reason:       err.Error(),
+	}); emitErr != nil {
+		k.Logger().Error("failed to emit event", "error", emitErr)
+	}
+
+	return channeltypes.NewErrorAcknowledgement(err)
+}
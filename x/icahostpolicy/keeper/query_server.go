@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/icahostpolicy/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// ConnectionPolicy returns the policy applied to a connection.
+func (k Querier) ConnectionPolicy(c context.Context, req *types.QueryConnectionPolicyRequest) (*types.QueryConnectionPolicyResponse, error) {
+	policy, source, chainID := k.Keeper.ConnectionPolicy(sdk.UnwrapSDKContext(c), req.ConnectionId)
+
+	return &types.QueryConnectionPolicyResponse{Policy: policy, Source: source, ChainId: chainID}, nil
+}
+
+// AccountSpend returns the spend of an interchain account in its current
+// window.
+func (k Querier) AccountSpend(c context.Context, req *types.QueryAccountSpendRequest) (*types.QueryAccountSpendResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	spend, err := k.GetSpend(sdk.UnwrapSDKContext(c), addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountSpendResponse{Spend: spend}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrInvalidSpend      = errorsmod.Register(ModuleName, 2, "invalid account spend")
+	ErrMsgNotAllowed     = errorsmod.Register(ModuleName, 3, "message type not allowed on this connection")
+	ErrTooManyMsgs       = errorsmod.Register(ModuleName, 4, "too many messages in packet")
+	ErrSpendLimitReached = errorsmod.Register(ModuleName, 5, "interchain account spend limit reached")
+)
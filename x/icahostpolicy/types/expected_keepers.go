@@ -0,0 +1,41 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ICAHostKeeper defines the ICA host methods used to resolve the interchain
+// account and encoding of a packet.
+type ICAHostKeeper interface {
+	GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+}
+
+// ChannelKeeper defines the channel methods used to find the connection of a
+// packet.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// ConnectionKeeper defines the connection methods used to find the client of
+// a connection.
+type ConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+
+// ClientKeeper defines the client methods used to find the chain ID of the
+// controller chain.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+}
+
+// BankKeeper defines the bank methods used to measure the spend of
+// interchain accounts.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, spends ...AccountSpend) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Spends: spends,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Spends))
+	for _, s := range gs.Spends {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		if seen[s.Address] {
+			return errorsmod.Wrapf(ErrInvalidSpend, "duplicate spend of %s", s.Address)
+		}
+		seen[s.Address] = true
+	}
+
+	return nil
+}
+
+// Validate checks the address, window and amount of the spend.
+func (s AccountSpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSpend, "invalid address: %s", err)
+	}
+
+	if s.WindowStart < 0 {
+		return errorsmod.Wrapf(ErrInvalidSpend, "negative window start %d", s.WindowStart)
+	}
+
+	if err := s.Spent.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSpend, "invalid spent: %s", err)
+	}
+
+	return nil
+}
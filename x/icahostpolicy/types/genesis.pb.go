@@ -0,0 +1,1762 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icahostpolicy/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the icahostpolicy module genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// spends are the amounts spent by interchain accounts in their current
+	// window.
+	Spends []AccountSpend `protobuf:"bytes,2,rep,name=spends,proto3" json:"spends"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSpends() []AccountSpend {
+	if m != nil {
+		return m.Spends
+	}
+	return nil
+}
+
+// Params defines the parameters of the icahostpolicy module.
+type Params struct {
+	// default_policy applies to connections without a connection or chain
+	// policy.
+	DefaultPolicy HostPolicy `protobuf:"bytes,1,opt,name=default_policy,json=defaultPolicy,proto3" json:"default_policy"`
+	// connection_policies override the policy of single connections.
+	ConnectionPolicies []ConnectionPolicy `protobuf:"bytes,2,rep,name=connection_policies,json=connectionPolicies,proto3" json:"connection_policies"`
+	// chain_policies override the policy of every connection to a controller
+	// chain. Connection policies take precedence.
+	ChainPolicies []ChainPolicy `protobuf:"bytes,3,rep,name=chain_policies,json=chainPolicies,proto3" json:"chain_policies"`
+	// spend_window_blocks is the number of blocks over which the spend limits
+	// apply.
+	SpendWindowBlocks int64 `protobuf:"varint,4,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDefaultPolicy() HostPolicy {
+	if m != nil {
+		return m.DefaultPolicy
+	}
+	return HostPolicy{}
+}
+
+func (m *Params) GetConnectionPolicies() []ConnectionPolicy {
+	if m != nil {
+		return m.ConnectionPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetChainPolicies() []ChainPolicy {
+	if m != nil {
+		return m.ChainPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetSpendWindowBlocks() int64 {
+	if m != nil {
+		return m.SpendWindowBlocks
+	}
+	return 0
+}
+
+// HostPolicy restricts the packets executed by interchain accounts. It
+// narrows the allow_messages param of the ICA host, which is still checked.
+type HostPolicy struct {
+	// allowed_msg_types are the type URLs of the messages interchain accounts
+	// may execute. "*" allows every message. Nested messages, e.g. of an authz
+	// MsgExec, are matched by their outer message only.
+	AllowedMsgTypes []string `protobuf:"bytes,1,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// max_msgs is the maximum number of messages in a packet, 0 for no limit.
+	MaxMsgs uint32 `protobuf:"varint,2,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty"`
+	// spend_limit caps the decrease of the balance of an interchain account in
+	// each spend window, for the listed denoms. Other denoms are not limited.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *HostPolicy) Reset()         { *m = HostPolicy{} }
+func (m *HostPolicy) String() string { return proto.CompactTextString(m) }
+func (*HostPolicy) ProtoMessage()    {}
+func (*HostPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{2}
+}
+func (m *HostPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostPolicy.Merge(m, src)
+}
+func (m *HostPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostPolicy proto.InternalMessageInfo
+
+func (m *HostPolicy) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *HostPolicy) GetMaxMsgs() uint32 {
+	if m != nil {
+		return m.MaxMsgs
+	}
+	return 0
+}
+
+func (m *HostPolicy) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// ConnectionPolicy is the policy of a single connection.
+type ConnectionPolicy struct {
+	// connection_id is the host side identifier of the connection.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// policy is the policy of the connection.
+	Policy HostPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *ConnectionPolicy) Reset()         { *m = ConnectionPolicy{} }
+func (m *ConnectionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConnectionPolicy) ProtoMessage()    {}
+func (*ConnectionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{3}
+}
+func (m *ConnectionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionPolicy.Merge(m, src)
+}
+func (m *ConnectionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionPolicy proto.InternalMessageInfo
+
+func (m *ConnectionPolicy) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionPolicy) GetPolicy() HostPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return HostPolicy{}
+}
+
+// ChainPolicy is the policy of every connection to a controller chain.
+type ChainPolicy struct {
+	// chain_id is the chain ID of the controller chain, as tracked by its
+	// light client.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// policy is the policy of the chain.
+	Policy HostPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *ChainPolicy) Reset()         { *m = ChainPolicy{} }
+func (m *ChainPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChainPolicy) ProtoMessage()    {}
+func (*ChainPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{4}
+}
+func (m *ChainPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainPolicy.Merge(m, src)
+}
+func (m *ChainPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainPolicy proto.InternalMessageInfo
+
+func (m *ChainPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainPolicy) GetPolicy() HostPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return HostPolicy{}
+}
+
+// AccountSpend is the amount spent by an interchain account since the start
+// of its spend window.
+type AccountSpend struct {
+	// address is the interchain account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// window_start is the height at which the window started.
+	WindowStart int64 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// spent is the decrease of the balance since window_start, for the denoms
+	// of the spend limit.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *AccountSpend) Reset()         { *m = AccountSpend{} }
+func (m *AccountSpend) String() string { return proto.CompactTextString(m) }
+func (*AccountSpend) ProtoMessage()    {}
+func (*AccountSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5aeca256d72412b, []int{5}
+}
+func (m *AccountSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountSpend.Merge(m, src)
+}
+func (m *AccountSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountSpend proto.InternalMessageInfo
+
+func (m *AccountSpend) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountSpend) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *AccountSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "icahostpolicy.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "icahostpolicy.v1.Params")
+	proto.RegisterType((*HostPolicy)(nil), "icahostpolicy.v1.HostPolicy")
+	proto.RegisterType((*ConnectionPolicy)(nil), "icahostpolicy.v1.ConnectionPolicy")
+	proto.RegisterType((*ChainPolicy)(nil), "icahostpolicy.v1.ChainPolicy")
+	proto.RegisterType((*AccountSpend)(nil), "icahostpolicy.v1.AccountSpend")
+}
+
+func init() { proto.RegisterFile("icahostpolicy/v1/genesis.proto", fileDescriptor_e5aeca256d72412b) }
+
+var fileDescriptor_e5aeca256d72412b = []byte{
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0xbb, 0x14, 0x8b, 0x9d, 0xb6, 0x08, 0x03, 0x87, 0x96, 0xe8, 0x82, 0xf5, 0xb2, 0x21,
+	0x61, 0xd7, 0xe2, 0x0d, 0x4f, 0x94, 0x83, 0xaf, 0x18, 0xb2, 0x35, 0x31, 0x7a, 0xd9, 0xcc, 0xce,
+	0x8e, 0xcb, 0x84, 0xdd, 0x99, 0xa6, 0x33, 0xe5, 0xe5, 0x2b, 0x78, 0x30, 0x7e, 0x0c, 0xe3, 0x89,
+	0x83, 0x5f, 0xc0, 0x1b, 0xf1, 0x44, 0x3c, 0x79, 0x52, 0x03, 0x07, 0xbe, 0x86, 0x99, 0x17, 0x60,
+	0x17, 0x3c, 0x98, 0x18, 0x2f, 0x0b, 0xf3, 0xfc, 0x9f, 0xe7, 0x37, 0xcf, 0x3e, 0xcf, 0x7f, 0x0b,
+	0x5c, 0x8a, 0xd1, 0x36, 0x17, 0x72, 0xc8, 0x33, 0x8a, 0x0f, 0x82, 0xdd, 0x5e, 0x90, 0x12, 0x46,
+	0x04, 0x15, 0xfe, 0x70, 0xc4, 0x25, 0x87, 0x33, 0x25, 0xdd, 0xdf, 0xed, 0x2d, 0xcc, 0xa7, 0x3c,
+	0xe5, 0x5a, 0x0c, 0xd4, 0x7f, 0x26, 0x6f, 0x61, 0x16, 0xe5, 0x94, 0xf1, 0x40, 0x3f, 0x6d, 0xa8,
+	0x83, 0xb9, 0xc8, 0xb9, 0x88, 0x4c, 0xae, 0x39, 0x58, 0xc9, 0x35, 0xa7, 0x20, 0x46, 0x82, 0x04,
+	0xbb, 0xbd, 0x98, 0x48, 0xd4, 0x0b, 0x30, 0xa7, 0xcc, 0xe8, 0xdd, 0xf7, 0x0e, 0x68, 0x3e, 0x32,
+	0x7d, 0x0c, 0x24, 0x92, 0x04, 0x3e, 0x04, 0xb5, 0x21, 0x1a, 0xa1, 0x5c, 0xb4, 0x9d, 0x25, 0xc7,
+	0x6b, 0xac, 0xb6, 0xfd, 0xab, 0x7d, 0xf9, 0x5b, 0x5a, 0xef, 0xd7, 0x8f, 0x7e, 0x2c, 0x56, 0x3e,
+	0x9e, 0x1d, 0x2e, 0x3b, 0xa1, 0x2d, 0x81, 0xeb, 0xa0, 0x26, 0x86, 0x84, 0x25, 0xa2, 0x3d, 0xb1,
+	0x54, 0xf5, 0x1a, 0xab, 0xee, 0xf5, 0xe2, 0x75, 0x8c, 0xf9, 0x98, 0xc9, 0x81, 0x4a, 0x2b, 0x21,
+	0x4c, 0x61, 0xf7, 0xeb, 0x04, 0xa8, 0x99, 0x0b, 0xe0, 0x0b, 0x30, 0x9d, 0x90, 0xb7, 0x68, 0x9c,
+	0xc9, 0xc8, 0xd4, 0xdb, 0x96, 0x6e, 0x5f, 0xa7, 0x3e, 0xe6, 0x42, 0x6e, 0xe9, 0x53, 0x91, 0xd9,
+	0xb2, 0xe5, 0x46, 0x81, 0xaf, 0xc1, 0x1c, 0xe6, 0x8c, 0x11, 0x2c, 0x29, 0x67, 0x06, 0x49, 0xc9,
+	0x79, 0xab, 0xdd, 0xeb, 0xd0, 0x8d, 0x8b, 0x64, 0x8b, 0x9e, 0x54, 0xe8, 0x10, 0xe2, 0x72, 0x9c,
+	0x12, 0x01, 0x9f, 0x82, 0x69, 0xbc, 0x8d, 0x68, 0x81, 0x5a, 0xd5, 0xd4, 0x3b, 0x7f, 0xa0, 0xaa,
+	0xbc, 0x12, 0xb0, 0x85, 0x2f, 0x42, 0x8a, 0xe5, 0x83, 0x39, 0x3d, 0x8b, 0x68, 0x8f, 0xb2, 0x84,
+	0xef, 0x45, 0x71, 0xc6, 0xf1, 0x8e, 0x68, 0x4f, 0x2e, 0x39, 0x5e, 0x35, 0x9c, 0xd5, 0xd2, 0x2b,
+	0xad, 0xf4, 0xb5, 0xb0, 0xd6, 0x79, 0x77, 0x76, 0xb8, 0x3c, 0x5f, 0x76, 0x97, 0x99, 0x60, 0xf7,
+	0x8b, 0x03, 0xc0, 0xe5, 0x68, 0xe0, 0x32, 0x98, 0x45, 0x59, 0xc6, 0xf7, 0x48, 0x12, 0xe5, 0x22,
+	0x8d, 0xe4, 0xc1, 0x90, 0xa8, 0x35, 0x57, 0xbd, 0x7a, 0x78, 0xcb, 0x0a, 0x9b, 0x22, 0x7d, 0xa9,
+	0xc2, 0xb0, 0x03, 0x6e, 0xe6, 0x68, 0x5f, 0xe5, 0xa9, 0x09, 0x39, 0x5e, 0x2b, 0x9c, 0xca, 0xd1,
+	0xfe, 0xa6, 0x48, 0x05, 0xcc, 0x40, 0xc3, 0x34, 0x98, 0xd1, 0x9c, 0x4a, 0xfb, 0xa6, 0x1d, 0xdf,
+	0xfa, 0x4e, 0x39, 0xcd, 0xb7, 0x4e, 0xf3, 0x37, 0x38, 0x65, 0xfd, 0xfb, 0xea, 0x2d, 0x3f, 0xfd,
+	0x5c, 0xf4, 0x52, 0x2a, 0xb7, 0xc7, 0xb1, 0x8f, 0x79, 0x6e, 0x4d, 0x6a, 0xff, 0xac, 0x88, 0x64,
+	0x27, 0xd0, 0xdd, 0xe8, 0x02, 0x11, 0x02, 0xcd, 0x7f, 0xae, 0xf0, 0x5d, 0x01, 0x66, 0xae, 0x2e,
+	0x02, 0xde, 0x03, 0xad, 0xc2, 0x26, 0x69, 0xa2, 0x8d, 0x51, 0x0f, 0x9b, 0x97, 0xc1, 0x27, 0x09,
+	0x5c, 0x03, 0x35, 0x6b, 0x9b, 0x89, 0xbf, 0xb0, 0x8d, 0x59, 0x85, 0xad, 0xe8, 0x26, 0xa0, 0x51,
+	0xd8, 0x93, 0x1a, 0x86, 0x59, 0xef, 0xc5, 0x55, 0x53, 0xfa, 0xfc, 0x8f, 0xb7, 0x1c, 0x39, 0xa0,
+	0x59, 0xfc, 0x1e, 0xe0, 0x2a, 0x98, 0x42, 0x49, 0x32, 0x22, 0xc2, 0x7c, 0x7d, 0xf5, 0x7e, 0xfb,
+	0xdb, 0xe7, 0x95, 0x79, 0x3b, 0xd8, 0x75, 0xa3, 0x0c, 0xe4, 0x88, 0xb2, 0x34, 0x3c, 0x4f, 0x84,
+	0x77, 0x41, 0xd3, 0x1a, 0x45, 0x48, 0x34, 0x92, 0xba, 0x8d, 0x6a, 0xd8, 0x30, 0xb1, 0x81, 0x0a,
+	0x41, 0x04, 0x6e, 0xa8, 0x81, 0xfe, 0x97, 0x55, 0x19, 0x72, 0xff, 0xd9, 0xd1, 0x89, 0xeb, 0x1c,
+	0x9f, 0xb8, 0xce, 0xaf, 0x13, 0xd7, 0xf9, 0x70, 0xea, 0x56, 0x8e, 0x4f, 0xdd, 0xca, 0xf7, 0x53,
+	0xb7, 0xf2, 0xa6, 0x57, 0x40, 0xf1, 0xb1, 0x8c, 0x89, 0x79, 0xae, 0x30, 0x9e, 0x90, 0x60, 0x3f,
+	0x28, 0xfb, 0x56, 0x93, 0xe3, 0x9a, 0xfe, 0x6d, 0x7a, 0xf0, 0x7b, 0x00, 0x52, 0x51, 0x15, 0x1b,
+	0x33, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spends) > 0 {
+		for iNdEx := len(m.Spends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendWindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SpendWindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainPolicies) > 0 {
+		for iNdEx := len(m.ChainPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionPolicies) > 0 {
+		for iNdEx := len(m.ConnectionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.DefaultPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HostPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxMsgs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Spends) > 0 {
+		for _, e := range m.Spends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConnectionPolicies) > 0 {
+		for _, e := range m.ConnectionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainPolicies) > 0 {
+		for _, e := range m.ChainPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SpendWindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.SpendWindowBlocks))
+	}
+	return n
+}
+
+func (m *HostPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxMsgs != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMsgs))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ChainPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AccountSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowStart))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spends = append(m.Spends, AccountSpend{})
+			if err := m.Spends[len(m.Spends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionPolicies = append(m.ConnectionPolicies, ConnectionPolicy{})
+			if err := m.ConnectionPolicies[len(m.ConnectionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainPolicies = append(m.ChainPolicies, ChainPolicy{})
+			if err := m.ChainPolicies[len(m.ChainPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+			}
+			m.SpendWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// SpendsKey saves the spend of each interchain account.
+	SpendsKey = collections.NewPrefix(1)
+)
+
+const (
+	ModuleName = "icahostpolicy"
+
+	// StoreKey differs from the module name, which has the store key of the
+	// ICA host as prefix.
+	StoreKey = "hostpolicy"
+
+	QuerierRoute = ModuleName
+)
+
+// Sources of the policy applied to a connection.
+const (
+	PolicySourceConnection = "connection"
+	PolicySourceChain      = "chain"
+	PolicySourceDefault    = "default"
+)
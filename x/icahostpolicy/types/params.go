@@ -0,0 +1,137 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// AllowAllMsgTypes is the allowed_msg_types entry that allows every
+	// message, like the allow_messages param of the ICA host.
+	AllowAllMsgTypes = "*"
+
+	// DefaultSpendWindowBlocks is about a day of 6 second blocks.
+	DefaultSpendWindowBlocks int64 = 14_400
+)
+
+// DefaultParams returns default module parameters. The default policy adds
+// no restriction to the ICA host params until governance sets one.
+func DefaultParams() Params {
+	return Params{
+		DefaultPolicy:      NewHostPolicy([]string{AllowAllMsgTypes}, 0, nil),
+		ConnectionPolicies: []ConnectionPolicy{},
+		ChainPolicies:      []ChainPolicy{},
+		SpendWindowBlocks:  DefaultSpendWindowBlocks,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(defaultPolicy HostPolicy, connectionPolicies []ConnectionPolicy, chainPolicies []ChainPolicy, spendWindowBlocks int64) Params {
+	return Params{
+		DefaultPolicy:      defaultPolicy,
+		ConnectionPolicies: connectionPolicies,
+		ChainPolicies:      chainPolicies,
+		SpendWindowBlocks:  spendWindowBlocks,
+	}
+}
+
+// NewHostPolicy creates a new HostPolicy instance.
+func NewHostPolicy(allowedMsgTypes []string, maxMsgs uint32, spendLimit sdk.Coins) HostPolicy {
+	return HostPolicy{
+		AllowedMsgTypes: allowedMsgTypes,
+		MaxMsgs:         maxMsgs,
+		SpendLimit:      spendLimit,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := p.DefaultPolicy.Validate(); err != nil {
+		return errorsmod.Wrap(err, "default policy")
+	}
+
+	seen := make(map[string]bool, len(p.ConnectionPolicies))
+	for _, cp := range p.ConnectionPolicies {
+		if err := host.ConnectionIdentifierValidator(cp.ConnectionId); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+		if seen[cp.ConnectionId] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate policy for connection %s", cp.ConnectionId)
+		}
+		seen[cp.ConnectionId] = true
+
+		if err := cp.Policy.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "connection %s", cp.ConnectionId)
+		}
+	}
+
+	seen = make(map[string]bool, len(p.ChainPolicies))
+	for _, cp := range p.ChainPolicies {
+		if cp.ChainId == "" {
+			return errorsmod.Wrap(ErrInvalidParams, "empty chain id")
+		}
+		if seen[cp.ChainId] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate policy for chain %s", cp.ChainId)
+		}
+		seen[cp.ChainId] = true
+
+		if err := cp.Policy.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "chain %s", cp.ChainId)
+		}
+	}
+
+	if p.SpendWindowBlocks <= 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "spend window must be positive")
+	}
+
+	return nil
+}
+
+// Policy returns the policy of a connection to chainID and where it comes
+// from. An empty chainID only matches connection policies.
+func (p Params) Policy(connectionID, chainID string) (HostPolicy, string) {
+	for _, cp := range p.ConnectionPolicies {
+		if cp.ConnectionId == connectionID {
+			return cp.Policy, PolicySourceConnection
+		}
+	}
+
+	if chainID != "" {
+		for _, cp := range p.ChainPolicies {
+			if cp.ChainId == chainID {
+				return cp.Policy, PolicySourceChain
+			}
+		}
+	}
+
+	return p.DefaultPolicy, PolicySourceDefault
+}
+
+// Validate does the sanity check on the policy.
+func (hp HostPolicy) Validate() error {
+	seen := make(map[string]bool, len(hp.AllowedMsgTypes))
+	for _, typeURL := range hp.AllowedMsgTypes {
+		if typeURL == "" {
+			return errorsmod.Wrap(ErrInvalidParams, "empty allowed msg type")
+		}
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate allowed msg type %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	if err := hp.SpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid spend limit: %s", err)
+	}
+
+	return nil
+}
+
+// IsAllowedMsgType reports whether the policy allows messages of typeURL.
+func (hp HostPolicy) IsAllowedMsgType(typeURL string) bool {
+	return slices.Contains(hp.AllowedMsgTypes, AllowAllMsgTypes) || slices.Contains(hp.AllowedMsgTypes, typeURL)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/msgfilter/types"
+)
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// IsBlocked reports whether a message with the given type url is rejected at
+// the current block height for the given signers.
+func (k Keeper) IsBlocked(ctx context.Context, typeURL string, signers []string) bool {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, b := range k.GetParams(ctx).BlockedMessages {
+		if b.TypeUrl != typeURL || !b.IsActive(height) {
+			continue
+		}
+
+		return !b.IsExempt(signers)
+	}
+
+	return false
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/msgfilter/keeper"
+	"github.com/outbe/outbe-node/x/msgfilter/types"
+)
+
+const msgSendURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx, k
+}
+
+func TestIsBlocked(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	exempt := sdk.AccAddress([]byte("exempt_address______")).String()
+	other := sdk.AccAddress([]byte("other_address_______")).String()
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams(types.BlockedMessage{
+		TypeUrl:         msgSendURL,
+		StartHeight:     10,
+		EndHeight:       20,
+		ExemptAddresses: []string{exempt},
+	})))
+
+	for _, tc := range []struct {
+		name    string
+		height  int64
+		typeURL string
+		signers []string
+		blocked bool
+	}{
+		{"before window", 9, msgSendURL, []string{other}, false},
+		{"window start", 10, msgSendURL, []string{other}, true},
+		{"window end", 20, msgSendURL, []string{other}, true},
+		{"after window", 21, msgSendURL, []string{other}, false},
+		{"unknown signers", 15, msgSendURL, nil, true},
+		{"exempt signer", 15, msgSendURL, []string{exempt}, false},
+		{"mixed signers", 15, msgSendURL, []string{exempt, other}, true},
+		{"other type", 15, "/cosmos.bank.v1beta1.MsgMultiSend", []string{other}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.blocked, k.IsBlocked(ctx.WithBlockHeight(tc.height), tc.typeURL, tc.signers))
+		})
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(types.BlockedMessage{TypeUrl: msgSendURL})
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.Error(t, err)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(types.BlockedMessage{TypeUrl: "cosmos.bank.v1beta1.MsgSend"}),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTypeURL)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	res, err := keeper.NewQuerier(k).BlockedMessages(ctx, &types.QueryBlockedMessagesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.BlockedMessages, 1)
+}
+
+func TestUnblockableParams(t *testing.T) {
+	for _, typeURL := range []string{
+		"/msgfilter.v1.MsgUpdateParams",
+		"/cosmos.gov.v1.MsgSubmitProposal",
+		"/cosmos.gov.v1.MsgVote",
+		"/cosmos.gov.v1beta1.MsgSubmitProposal",
+		"/cosmos.gov.v1beta1.MsgVote",
+	} {
+		t.Run(typeURL, func(t *testing.T) {
+			// a window or exempt addresses do not make the entry safe
+			params := types.NewParams(types.BlockedMessage{TypeUrl: typeURL, StartHeight: 10, EndHeight: 20})
+			require.ErrorIs(t, params.Validate(), types.ErrInvalidTypeURL)
+		})
+	}
+
+	require.NoError(t, types.NewParams(types.BlockedMessage{TypeUrl: "/cosmos.gov.v1.MsgDeposit"}).Validate())
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/msgfilter/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// BlockedMessages returns the filters that apply at the current height.
+func (k Querier) BlockedMessages(c context.Context, _ *types.QueryBlockedMessagesRequest) (*types.QueryBlockedMessagesResponse, error) {
+	height := sdk.UnwrapSDKContext(c).BlockHeight()
+
+	return &types.QueryBlockedMessagesResponse{
+		Height:          height,
+		BlockedMessages: k.GetParams(c).ActiveAt(height),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidTypeURL     = errorsmod.Register(ModuleName, 1, "invalid message type url")
+	ErrInvalidHeightRange = errorsmod.Register(ModuleName, 2, "invalid height range")
+	ErrBlockedMessage     = errorsmod.Register(ModuleName, 3, "message type is blocked")
+)
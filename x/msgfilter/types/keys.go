@@ -0,0 +1,18 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+)
+
+const (
+	ModuleName = "msgfilter"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
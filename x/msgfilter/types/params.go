@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// unblockable lists the messages a governance proposal needs to change the
+// params. Blocking any of them would leave no way to unblock it.
+var unblockable = map[string]bool{
+	"/msgfilter.v1.MsgUpdateParams":         true,
+	"/cosmos.gov.v1.MsgSubmitProposal":      true,
+	"/cosmos.gov.v1.MsgVote":                true,
+	"/cosmos.gov.v1beta1.MsgSubmitProposal": true,
+	"/cosmos.gov.v1beta1.MsgVote":           true,
+}
+
+// DefaultParams returns default module parameters. Nothing is blocked by default.
+func DefaultParams() Params {
+	return Params{
+		BlockedMessages: []BlockedMessage{},
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(blocked ...BlockedMessage) Params {
+	return Params{
+		BlockedMessages: blocked,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.BlockedMessages))
+	for _, b := range p.BlockedMessages {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+
+		if unblockable[b.TypeUrl] {
+			return errorsmod.Wrapf(ErrInvalidTypeURL, "%s cannot be blocked, it is needed to change the params", b.TypeUrl)
+		}
+
+		// overlapping entries for the same type url are ambiguous for exemptions
+		if seen[b.TypeUrl] {
+			return errorsmod.Wrapf(ErrInvalidTypeURL, "duplicate type url %s", b.TypeUrl)
+		}
+		seen[b.TypeUrl] = true
+	}
+
+	return nil
+}
+
+// ActiveAt returns the blocked messages whose height window contains height.
+func (p Params) ActiveAt(height int64) []BlockedMessage {
+	active := make([]BlockedMessage, 0, len(p.BlockedMessages))
+	for _, b := range p.BlockedMessages {
+		if b.IsActive(height) {
+			active = append(active, b)
+		}
+	}
+
+	return active
+}
+
+// Validate checks a single blocked message entry.
+func (b BlockedMessage) Validate() error {
+	if !strings.HasPrefix(b.TypeUrl, "/") || len(b.TypeUrl) < 2 || strings.ContainsAny(b.TypeUrl, " \t\n") {
+		return errorsmod.Wrapf(ErrInvalidTypeURL, "%q must be of the form /package.Msg", b.TypeUrl)
+	}
+
+	if b.StartHeight < 0 || b.EndHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidHeightRange, "heights must not be negative for %s", b.TypeUrl)
+	}
+
+	if b.EndHeight != 0 && b.EndHeight < b.StartHeight {
+		return errorsmod.Wrapf(ErrInvalidHeightRange, "end height %d is before start height %d for %s", b.EndHeight, b.StartHeight, b.TypeUrl)
+	}
+
+	for _, addr := range b.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid exempt address %s for %s: %w", addr, b.TypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// IsActive reports whether the entry applies at the given height.
+func (b BlockedMessage) IsActive(height int64) bool {
+	if b.StartHeight != 0 && height < b.StartHeight {
+		return false
+	}
+
+	if b.EndHeight != 0 && height > b.EndHeight {
+		return false
+	}
+
+	return true
+}
+
+// IsExempt reports whether all signers are listed as exempt. A message without
+// known signers is never exempt.
+func (b BlockedMessage) IsExempt(signers []string) bool {
+	if len(signers) == 0 || len(b.ExemptAddresses) == 0 {
+		return false
+	}
+
+	for _, signer := range signers {
+		found := false
+		for _, addr := range b.ExemptAddresses {
+			if addr == signer {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,58 @@
+package poa
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "poa.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current poa parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "poa.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+				{
+					RpcMethod: "AddValidator",
+					Use:       "add-validator [validator-address] [pubkey] [power]",
+					Short:     "Create a validator with the given consensus power",
+					Long:      "Create a validator with the given consensus power. The pubkey is the JSON output of `tendermint show-validator`; description and commission are optional JSON flags.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "pubkey"},
+						{ProtoField: "power"},
+					},
+				},
+				{
+					RpcMethod: "RemoveValidator",
+					Use:       "remove-validator [validator-address]",
+					Short:     "Remove all power of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "SetPower",
+					Use:       "set-power [validator-address] [power]",
+					Short:     "Set the consensus power of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "power"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/outbe/outbe-node/x/poa/types"
+)
+
+// restrictedMsgs are the staking messages only admins may send while PoA is
+// enabled.
+var restrictedMsgs = map[string]bool{
+	sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}):           true,
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):                  true,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):                true,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):           true,
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}): true,
+}
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	stakingKeeper types.StakingKeeper
+	stakingMsgs   stakingtypes.MsgServer
+	bankKeeper    types.BankKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	stakingKeeper types.StakingKeeper,
+	stakingMsgs stakingtypes.MsgServer,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		stakingKeeper: stakingKeeper,
+		stakingMsgs:   stakingMsgs,
+		bankKeeper:    bankKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// IsAdmin reports whether addr may manage the validator set.
+func (k Keeper) IsAdmin(ctx context.Context, addr string) bool {
+	return addr == k.authority || k.GetParams(ctx).IsAdmin(addr)
+}
+
+// IsBlocked reports whether a staking message is rejected for the given
+// signers. While PoA is enabled only admins may create validators or move
+// delegations. Gentxs are always accepted so the genesis validator set can be
+// bootstrapped.
+func (k Keeper) IsBlocked(ctx context.Context, typeURL string, signers []string) bool {
+	if !restrictedMsgs[typeURL] || sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return false
+	}
+
+	if !k.GetParams(ctx).Enabled {
+		return false
+	}
+
+	for _, signer := range signers {
+		if !k.IsAdmin(ctx, signer) {
+			return true
+		}
+	}
+
+	return len(signers) == 0
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
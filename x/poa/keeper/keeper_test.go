@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/outbe/outbe-node/x/poa/keeper"
+	"github.com/outbe/outbe-node/x/poa/types"
+)
+
+var (
+	admin = sdk.AccAddress([]byte("admin_address_______")).String()
+	other = sdk.AccAddress([]byte("other_address_______")).String()
+
+	createValidatorURL = sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})
+	delegateURL        = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	// the staking and bank keepers are only used to change validator power,
+	// which is covered by the app tests
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		nil,
+		nil,
+		nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx.WithBlockHeight(1), k
+}
+
+func TestIsBlocked(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	gov := k.GetAuthority()
+
+	// staking is permissionless while PoA is disabled
+	require.False(t, k.IsBlocked(ctx, createValidatorURL, []string{other}))
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams(true, admin)))
+
+	for _, tc := range []struct {
+		name    string
+		typeURL string
+		signers []string
+		blocked bool
+	}{
+		{"create validator", createValidatorURL, []string{other}, true},
+		{"delegate", delegateURL, []string{other}, true},
+		{"unknown signers", delegateURL, nil, true},
+		{"admin", delegateURL, []string{admin}, false},
+		{"authority", delegateURL, []string{gov}, false},
+		{"admin with other", delegateURL, []string{admin, other}, true},
+		{"other type", sdk.MsgTypeURL(&banktypes.MsgSend{}), []string{other}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.blocked, k.IsBlocked(ctx, tc.typeURL, tc.signers))
+		})
+	}
+
+	// gentxs are delivered at height zero
+	require.False(t, k.IsBlocked(ctx.WithBlockHeight(0), createValidatorURL, []string{other}))
+}
+
+func TestMsgServerAuthorization(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	valAddr := sdk.ValAddress([]byte("validator_address___")).String()
+
+	_, err := ms.SetPower(ctx, &types.MsgSetPower{Sender: admin, ValidatorAddress: valAddr, Power: 1})
+	require.ErrorIs(t, err, types.ErrDisabled)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: admin, Params: types.NewParams(true, admin)})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(true, admin, admin)})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(true, admin)})
+	require.NoError(t, err)
+
+	_, err = ms.RemoveValidator(ctx, &types.MsgRemoveValidator{Sender: other, ValidatorAddress: valAddr})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	gs := types.NewGenesisState(types.NewParams(true, admin))
+	require.NoError(t, k.InitGenesis(ctx, gs))
+	require.Equal(t, gs, k.ExportGenesis(ctx))
+}
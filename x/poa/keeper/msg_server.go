@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/poa/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams replaces the module params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddValidator creates a validator with the given power.
+func (ms msgServer) AddValidator(ctx context.Context, msg *types.MsgAddValidator) (*types.MsgAddValidatorResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.AddValidator(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddValidatorResponse{}, nil
+}
+
+// RemoveValidator removes all power of a validator.
+func (ms msgServer) RemoveValidator(ctx context.Context, msg *types.MsgRemoveValidator) (*types.MsgRemoveValidatorResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetPower(ctx, msg.ValidatorAddress, 0); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveValidatorResponse{}, nil
+}
+
+// SetPower sets the consensus power of a validator.
+func (ms msgServer) SetPower(ctx context.Context, msg *types.MsgSetPower) (*types.MsgSetPowerResponse, error) {
+	if err := ms.checkAdmin(ctx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetPower(ctx, msg.ValidatorAddress, msg.Power); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPowerResponse{}, nil
+}
+
+// checkAdmin rejects validator set changes while PoA is disabled or when the
+// sender is not an admin.
+func (ms msgServer) checkAdmin(ctx context.Context, sender string) error {
+	if !ms.k.GetParams(ctx).Enabled {
+		return types.ErrDisabled
+	}
+
+	if !ms.k.IsAdmin(ctx, sender) {
+		return errors.Wrap(types.ErrUnauthorized, sender)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/outbe/outbe-node/x/poa/types"
+)
+
+// The power of a PoA validator is backed by a self-delegation of bond denom
+// tokens that the module mints when power is added and burns when it is
+// removed. Staking, distribution and slashing keep working on top of it
+// unchanged: a slashed validator loses tokens like any other validator and
+// regains power when an admin sets it again.
+
+// AddValidator creates a validator whose self-delegation matches power.
+func (k Keeper) AddValidator(ctx context.Context, msg *types.MsgAddValidator) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.stakingKeeper.GetValidator(ctx, valAddr); err == nil {
+		return errorsmod.Wrap(types.ErrValidatorExists, msg.ValidatorAddress)
+	}
+
+	stake, err := k.mintStake(ctx, sdk.AccAddress(valAddr), sdk.TokensFromConsensusPower(msg.Power, k.stakingKeeper.PowerReduction(ctx)))
+	if err != nil {
+		return err
+	}
+
+	// commission is optional for PoA validators
+	commission := msg.Commission
+	if commission.Rate.IsNil() {
+		commission = stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	}
+
+	_, err = k.stakingMsgs.CreateValidator(ctx, &stakingtypes.MsgCreateValidator{
+		Description:       msg.Description,
+		Commission:        commission,
+		MinSelfDelegation: math.OneInt(),
+		ValidatorAddress:  msg.ValidatorAddress,
+		Pubkey:            msg.Pubkey,
+		Value:             stake,
+	})
+
+	return err
+}
+
+// SetPower mints or burns self-delegated stake so that the validator's tokens
+// match power. A power of zero removes the validator from the active set.
+func (k Keeper) SetPower(ctx context.Context, validatorAddress string, power int64) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validatorAddress)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	target := sdk.TokensFromConsensusPower(power, k.stakingKeeper.PowerReduction(ctx))
+
+	switch {
+	case target.GT(validator.Tokens):
+		diff := target.Sub(validator.Tokens)
+		if _, err := k.mintStake(ctx, sdk.AccAddress(valAddr), diff); err != nil {
+			return err
+		}
+
+		_, err = k.stakingKeeper.Delegate(ctx, sdk.AccAddress(valAddr), diff, stakingtypes.Unbonded, validator, true)
+		return err
+
+	case target.LT(validator.Tokens):
+		if power == 0 {
+			if err := k.assertNotLast(ctx, validator); err != nil {
+				return err
+			}
+		}
+
+		return k.burnStake(ctx, validator, validator.Tokens.Sub(target))
+	}
+
+	return nil
+}
+
+// mintStake mints tokens of the bond denom to the validator operator so they
+// can be self-delegated.
+func (k Keeper) mintStake(ctx context.Context, operator sdk.AccAddress, tokens math.Int) (sdk.Coin, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	stake := sdk.NewCoin(bondDenom, tokens)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(stake)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, operator, sdk.NewCoins(stake)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return stake, nil
+}
+
+// burnStake unbonds tokens from the validator's self-delegation and burns them
+// from the staking pool that holds them.
+func (k Keeper) burnStake(ctx context.Context, validator stakingtypes.Validator, tokens math.Int) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	shares, err := validator.SharesFromTokens(tokens)
+	if err != nil {
+		return err
+	}
+
+	amount, err := k.stakingKeeper.Unbond(ctx, sdk.AccAddress(valAddr), valAddr, shares)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+}
+
+// assertNotLast rejects removing the only validator that still has power, as
+// that would halt the chain.
+func (k Keeper) assertNotLast(ctx context.Context, validator stakingtypes.Validator) error {
+	total, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	if total.Int64() <= validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx)) {
+		return errorsmod.Wrap(types.ErrLastValidator, validator.GetOperator())
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	AminoCdc  = codec.NewAminoCodec(amino)
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddValidator{}, ModuleName+"/MsgAddValidator", nil)
+	cdc.RegisterConcrete(&MsgRemoveValidator{}, ModuleName+"/MsgRemoveValidator", nil)
+	cdc.RegisterConcrete(&MsgSetPower{}, ModuleName+"/MsgSetPower", nil)
+}
+
+// RegisterInterfaces registers the module's interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgAddValidator{},
+		&MsgRemoveValidator{},
+		&MsgSetPower{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams   = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrDisabled        = errorsmod.Register(ModuleName, 2, "proof-of-authority is disabled")
+	ErrUnauthorized    = errorsmod.Register(ModuleName, 3, "sender is not a poa admin")
+	ErrInvalidPower    = errorsmod.Register(ModuleName, 4, "invalid validator power")
+	ErrLastValidator   = errorsmod.Register(ModuleName, 5, "cannot remove the power of the last validator")
+	ErrValidatorExists = errorsmod.Register(ModuleName, 6, "validator already exists")
+)
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddValidator{}
+	_ sdk.Msg = &MsgRemoveValidator{}
+	_ sdk.Msg = &MsgSetPower{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgAddValidator)(nil)
+)
+
+// NewMsgUpdateParams creates new instance of MsgUpdateParams
+func NewMsgUpdateParams(
+	sender sdk.Address,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: sender.String(),
+		Params:    params,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgAddValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if msg.Pubkey == nil {
+		return errors.Wrap(ErrInvalidParams, "missing consensus pubkey")
+	}
+
+	if msg.Power <= 0 {
+		return errors.Wrapf(ErrInvalidPower, "power must be positive, got %d", msg.Power)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.Pubkey, &pubKey)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRemoveValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetPower) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return errors.Wrap(err, "invalid validator address")
+	}
+
+	if msg.Power < 0 {
+		return errors.Wrapf(ErrInvalidPower, "power must not be negative, got %d", msg.Power)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default module parameters. PoA is disabled by default
+// so the chain runs plain proof-of-stake unless genesis opts in.
+func DefaultParams() Params {
+	return Params{
+		Enabled: false,
+		Admins:  []string{},
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(enabled bool, admins ...string) Params {
+	return Params{
+		Enabled: enabled,
+		Admins:  admins,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Admins))
+	for _, admin := range p.Admins {
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid admin %s: %s", admin, err)
+		}
+
+		if seen[admin] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate admin %s", admin)
+		}
+		seen[admin] = true
+	}
+
+	return nil
+}
+
+// IsAdmin reports whether addr is one of the configured admins.
+func (p Params) IsAdmin(addr string) bool {
+	for _, admin := range p.Admins {
+		if admin == addr {
+			return true
+		}
+	}
+
+	return false
+}
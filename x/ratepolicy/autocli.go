@@ -0,0 +1,49 @@
+package ratepolicy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "ratepolicy.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the rate limit templates and the admin",
+				},
+				{
+					RpcMethod:      "Template",
+					Use:            "template [denom]",
+					Short:          "Query the rate limit template of a denom",
+					Long:           "Query the rate limit template applied to a denom on new transfer channels, or with --ibc to the IBC denoms of this base denom received for the first time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "ratepolicy.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+				{
+					RpcMethod: "TightenRateLimit",
+					Use:       "tighten [denom] [channel-id] [max-percent-send] [max-percent-recv] [duration-hours]",
+					Short:     "Add a rate limit or lower the quota of an existing one as the admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "channel_id"},
+						{ProtoField: "max_percent_send"},
+						{ProtoField: "max_percent_recv"},
+						{ProtoField: "duration_hours"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+
+	"github.com/outbe/outbe-node/x/ratepolicy/types"
+)
+
+type Keeper struct {
+	cdc codec.Codec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+
+	rateLimitKeeper types.RateLimitKeeper
+	transferKeeper  types.TransferKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	rateLimitKeeper types.RateLimitKeeper,
+	transferKeeper types.TransferKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		rateLimitKeeper: rateLimitKeeper,
+		transferKeeper:  transferKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// ApplyChannelTemplates creates the rate limits of a new transfer channel
+// from the templates of denoms with a supply.
+func (k Keeper) ApplyChannelTemplates(ctx sdk.Context, channelID string) {
+	for _, t := range k.GetParams(ctx).Templates {
+		if t.Denom != types.AnyIBCDenom {
+			k.applyTemplate(ctx, t.Denom, channelID, t)
+		}
+	}
+}
+
+// ApplyDenomTemplate creates the rate limit of an IBC denom received for the
+// first time over channelID from the template of its base denom.
+func (k Keeper) ApplyDenomTemplate(ctx sdk.Context, denom, baseDenom, channelID string) {
+	if t, found := k.GetParams(ctx).Template(baseDenom, true); found {
+		k.applyTemplate(ctx, denom, channelID, t)
+	}
+}
+
+// applyTemplate adds the rate limit of denom on channelID unless one exists.
+// Denoms without a supply can not be rate limited yet and are skipped.
+func (k Keeper) applyTemplate(ctx sdk.Context, denom, channelID string, t types.RateLimitTemplate) {
+	if _, found := k.rateLimitKeeper.GetRateLimit(ctx, denom, channelID); found {
+		return
+	}
+
+	err := k.rateLimitKeeper.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+		Authority:      k.authority,
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: t.MaxPercentSend,
+		MaxPercentRecv: t.MaxPercentRecv,
+		DurationHours:  t.DurationHours,
+	})
+	if errors.Is(err, ratelimittypes.ErrZeroChannelValue) {
+		return
+	}
+	if err != nil {
+		k.Logger().Error("failed to apply rate limit template", "denom", denom, "channel", channelID, "template", t.Denom, "error", err)
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRateLimitApplied{
+		Denom:     denom,
+		ChannelId: channelID,
+		Template:  t.Denom,
+	}); err != nil {
+		k.Logger().Error("failed to emit event", "error", err)
+	}
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
+
+// TightenRateLimit adds the rate limit of msg or lowers the quota of the
+// existing one, keeping its current flow.
+func (k Keeper) TightenRateLimit(ctx sdk.Context, msg *types.MsgTightenRateLimit) error {
+	if msg.Signer != k.authority && msg.Signer != k.GetParams(ctx).Admin {
+		return errorsmod.Wrap(types.ErrUnauthorized, msg.Signer)
+	}
+
+	rateLimit, found := k.rateLimitKeeper.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
+	if !found {
+		if msg.DurationHours == 0 {
+			return errorsmod.Wrap(types.ErrInvalidRateLimit, "a new rate limit needs a duration")
+		}
+
+		// AddRateLimit requires a non-zero quota, set the requested one after
+		err := k.rateLimitKeeper.AddRateLimit(ctx, &ratelimittypes.MsgAddRateLimit{
+			Authority:      k.authority,
+			Denom:          msg.Denom,
+			ChannelId:      msg.ChannelId,
+			MaxPercentSend: sdkmath.NewInt(100),
+			MaxPercentRecv: sdkmath.NewInt(100),
+			DurationHours:  msg.DurationHours,
+		})
+		if err != nil {
+			return err
+		}
+
+		rateLimit, _ = k.rateLimitKeeper.GetRateLimit(ctx, msg.Denom, msg.ChannelId)
+	} else {
+		quota := rateLimit.Quota
+		if msg.MaxPercentSend.GT(quota.MaxPercentSend) || msg.MaxPercentRecv.GT(quota.MaxPercentRecv) {
+			return errorsmod.Wrapf(types.ErrNotTighter, "quota %s/%s above the current %s/%s", msg.MaxPercentSend, msg.MaxPercentRecv, quota.MaxPercentSend, quota.MaxPercentRecv)
+		}
+		if msg.DurationHours != 0 && msg.DurationHours < quota.DurationHours {
+			return errorsmod.Wrapf(types.ErrNotTighter, "duration %d below the current %d hours", msg.DurationHours, quota.DurationHours)
+		}
+	}
+
+	quota := *rateLimit.Quota
+	quota.MaxPercentSend = msg.MaxPercentSend
+	quota.MaxPercentRecv = msg.MaxPercentRecv
+	if msg.DurationHours != 0 {
+		quota.DurationHours = msg.DurationHours
+	}
+	rateLimit.Quota = &quota
+	k.rateLimitKeeper.SetRateLimit(ctx, rateLimit)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRateLimitTightened{
+		Signer:         msg.Signer,
+		Denom:          msg.Denom,
+		ChannelId:      msg.ChannelId,
+		MaxPercentSend: quota.MaxPercentSend.String(),
+		MaxPercentRecv: quota.MaxPercentRecv.String(),
+		DurationHours:  quota.DurationHours,
+	})
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/outbe/outbe-node/x/ratepolicy/keeper"
+	"github.com/outbe/outbe-node/x/ratepolicy/types"
+)
+
+const (
+	channelID = "channel-0"
+	native    = "uoutbe"
+)
+
+var (
+	admin = sdk.AccAddress([]byte("admin_______________"))
+	other = sdk.AccAddress([]byte("other_address_______"))
+)
+
+// mockRateLimit stores rate limits like the ratelimit keeper, which refuses
+// denoms without a supply.
+type mockRateLimit struct {
+	supply     map[string]int64
+	rateLimits map[string]ratelimittypes.RateLimit
+}
+
+func (m *mockRateLimit) AddRateLimit(_ sdk.Context, msg *ratelimittypes.MsgAddRateLimit) error {
+	if m.supply[msg.Denom] == 0 {
+		return ratelimittypes.ErrZeroChannelValue
+	}
+	if _, found := m.rateLimits[msg.Denom+msg.ChannelId]; found {
+		return ratelimittypes.ErrRateLimitAlreadyExists
+	}
+
+	m.rateLimits[msg.Denom+msg.ChannelId] = ratelimittypes.RateLimit{
+		Path:  &ratelimittypes.Path{Denom: msg.Denom, ChannelId: msg.ChannelId},
+		Quota: &ratelimittypes.Quota{MaxPercentSend: msg.MaxPercentSend, MaxPercentRecv: msg.MaxPercentRecv, DurationHours: msg.DurationHours},
+		Flow:  &ratelimittypes.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(m.supply[msg.Denom])},
+	}
+	return nil
+}
+
+func (m *mockRateLimit) GetRateLimit(_ sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool) {
+	rl, found := m.rateLimits[denom+channelID]
+	return rl, found
+}
+
+func (m *mockRateLimit) SetRateLimit(_ sdk.Context, rl ratelimittypes.RateLimit) {
+	m.rateLimits[rl.Path.Denom+rl.Path.ChannelId] = rl
+}
+
+// mockTransfer holds the denom traces created by received packets.
+type mockTransfer struct {
+	traces map[string]bool
+}
+
+func (m *mockTransfer) HasDenomTrace(_ sdk.Context, hash cmtbytes.HexBytes) bool {
+	return m.traces[hash.String()]
+}
+
+type fixture struct {
+	ctx       sdk.Context
+	k         keeper.Keeper
+	rateLimit *mockRateLimit
+	transfer  *mockTransfer
+}
+
+func setupKeeper(t *testing.T) fixture {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	f := fixture{
+		ctx:       testCtx.Ctx,
+		rateLimit: &mockRateLimit{supply: map[string]int64{native: 1_000}, rateLimits: map[string]ratelimittypes.RateLimit{}},
+		transfer:  &mockTransfer{traces: map[string]bool{}},
+	}
+
+	f.k = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		f.rateLimit,
+		f.transfer,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	params := types.NewParams([]types.RateLimitTemplate{
+		types.NewRateLimitTemplate(native, sdkmath.NewInt(10), sdkmath.NewInt(20), 24),
+		types.NewRateLimitTemplate("uatom", sdkmath.NewInt(30), sdkmath.NewInt(30), 12),
+		types.NewRateLimitTemplate(types.AnyIBCDenom, sdkmath.NewInt(5), sdkmath.NewInt(5), 6),
+	}, admin.String())
+	require.NoError(t, f.k.SetParams(f.ctx, params))
+
+	return f
+}
+
+// recv returns a transfer packet of denom received on channelID.
+func recv(denom string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "100", other.String(), other.String(), "")
+	return channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-9",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: channelID,
+		Data:               data.GetBytes(),
+	}
+}
+
+func (f fixture) quota(t *testing.T, denom string) ratelimittypes.Quota {
+	t.Helper()
+
+	rl, found := f.rateLimit.GetRateLimit(f.ctx, denom, channelID)
+	require.True(t, found, denom)
+	return *rl.Quota
+}
+
+func TestApplyChannelTemplates(t *testing.T) {
+	f := setupKeeper(t)
+
+	f.k.ApplyChannelTemplates(f.ctx, channelID)
+
+	require.Equal(t, ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(20), DurationHours: 24}, f.quota(t, native))
+	// uatom has no supply here, the wildcard is only for received denoms
+	require.Len(t, f.rateLimit.rateLimits, 1)
+
+	// existing rate limits are left as governance set them
+	f.rateLimit.SetRateLimit(f.ctx, ratelimittypes.RateLimit{
+		Path:  &ratelimittypes.Path{Denom: native, ChannelId: channelID},
+		Quota: &ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(50), MaxPercentRecv: sdkmath.NewInt(50), DurationHours: 1},
+	})
+	f.k.ApplyChannelTemplates(f.ctx, channelID)
+	require.Equal(t, sdkmath.NewInt(50), f.quota(t, native).MaxPercentSend)
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	f := setupKeeper(t)
+
+	atom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "uatom"))
+	osmo := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "uosmo"))
+
+	// transfer mints the voucher and stores its trace
+	next := func(trace transfertypes.DenomTrace, success bool) func() ibcexported.Acknowledgement {
+		return func() ibcexported.Acknowledgement {
+			if !success {
+				return channeltypes.NewErrorAcknowledgement(transfertypes.ErrInvalidAmount)
+			}
+			f.transfer.traces[trace.Hash().String()] = true
+			f.rateLimit.supply[trace.IBCDenom()] += 100
+			return channeltypes.NewResultAcknowledgement([]byte{1})
+		}
+	}
+
+	// failed receives mint nothing
+	require.False(t, f.k.OnRecvPacket(f.ctx, recv("uatom"), next(atom, false)).Success())
+	require.Empty(t, f.rateLimit.rateLimits)
+
+	require.True(t, f.k.OnRecvPacket(f.ctx, recv("uatom"), next(atom, true)).Success())
+	require.Equal(t, sdkmath.NewInt(30), f.quota(t, atom.IBCDenom()).MaxPercentSend)
+
+	// denoms without a template of their own get the wildcard one
+	require.True(t, f.k.OnRecvPacket(f.ctx, recv("uosmo"), next(osmo, true)).Success())
+	require.Equal(t, uint64(6), f.quota(t, osmo.IBCDenom()).DurationHours)
+
+	// later packets of a known denom are left to the existing rate limit
+	delete(f.rateLimit.rateLimits, osmo.IBCDenom()+channelID)
+	require.True(t, f.k.OnRecvPacket(f.ctx, recv("uosmo"), next(osmo, true)).Success())
+	require.Len(t, f.rateLimit.rateLimits, 1)
+
+	// native tokens coming back are unescrowed, not minted
+	require.True(t, f.k.OnRecvPacket(f.ctx, recv("transfer/channel-9/"+native), next(transfertypes.DenomTrace{}, true)).Success())
+	require.Len(t, f.rateLimit.rateLimits, 1)
+}
+
+func TestTightenRateLimit(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	tighten := func(signer sdk.AccAddress, send, recv int64, duration uint64) error {
+		_, err := ms.TightenRateLimit(f.ctx, types.NewMsgTightenRateLimit(signer, native, channelID, sdkmath.NewInt(send), sdkmath.NewInt(recv), duration))
+		return err
+	}
+
+	require.ErrorIs(t, tighten(other, 0, 0, 24), types.ErrUnauthorized)
+	require.ErrorIs(t, tighten(admin, 10, 10, 0), types.ErrInvalidRateLimit)
+
+	// unlike governance, the admin can halt both directions
+	require.NoError(t, tighten(admin, 0, 0, 24))
+	require.Equal(t, ratelimittypes.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxPercentRecv: sdkmath.ZeroInt(), DurationHours: 24}, f.quota(t, native))
+
+	require.ErrorIs(t, tighten(admin, 1, 0, 0), types.ErrNotTighter)
+	require.ErrorIs(t, tighten(admin, 0, 0, 1), types.ErrNotTighter)
+
+	// the flow is kept, tightening does not grant a new quota
+	rl, _ := f.rateLimit.GetRateLimit(f.ctx, native, channelID)
+	rl.Flow.Outflow = sdkmath.NewInt(7)
+	rl.Quota.MaxPercentSend = sdkmath.NewInt(10)
+	f.rateLimit.SetRateLimit(f.ctx, rl)
+
+	require.NoError(t, tighten(authtypes.NewModuleAddress(govtypes.ModuleName), 5, 0, 48))
+	rl, _ = f.rateLimit.GetRateLimit(f.ctx, native, channelID)
+	require.Equal(t, sdkmath.NewInt(7), rl.Flow.Outflow)
+	require.Equal(t, ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(5), MaxPercentRecv: sdkmath.ZeroInt(), DurationHours: 48}, *rl.Quota)
+
+	// without an admin only governance can tighten
+	require.NoError(t, f.k.SetParams(f.ctx, types.DefaultParams()))
+	require.ErrorIs(t, tighten(admin, 0, 0, 0), types.ErrUnauthorized)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	f := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(f.k)
+
+	params := types.NewParams(nil, other.String())
+
+	_, err := ms.UpdateParams(f.ctx, types.NewMsgUpdateParams(other, params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, other.String(), f.k.GetParams(f.ctx).Admin)
+
+	valid := types.NewRateLimitTemplate(native, sdkmath.NewInt(10), sdkmath.NewInt(10), 1)
+	for _, invalid := range []types.Params{
+		types.NewParams(nil, "admin"),
+		types.NewParams([]types.RateLimitTemplate{valid, valid}, ""),
+		types.NewParams([]types.RateLimitTemplate{types.NewRateLimitTemplate(native, sdkmath.NewInt(101), sdkmath.NewInt(10), 1)}, ""),
+		types.NewParams([]types.RateLimitTemplate{types.NewRateLimitTemplate(native, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 1)}, ""),
+		types.NewParams([]types.RateLimitTemplate{types.NewRateLimitTemplate(native, sdkmath.NewInt(10), sdkmath.NewInt(10), 0)}, ""),
+	} {
+		_, err = ms.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: f.k.GetAuthority(), Params: invalid})
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	}
+}
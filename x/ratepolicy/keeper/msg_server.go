@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/ratepolicy/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams replaces the templates and the admin.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// TightenRateLimit adds or tightens a rate limit on behalf of the admin or
+// governance.
+func (ms msgServer) TightenRateLimit(goCtx context.Context, msg *types.MsgTightenRateLimit) (*types.MsgTightenRateLimitResponse, error) {
+	if err := ms.k.TightenRateLimit(sdk.UnwrapSDKContext(goCtx), msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTightenRateLimitResponse{}, nil
+}
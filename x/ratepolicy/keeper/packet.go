@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// OnRecvPacket calls next to receive a transfer packet and rate limits the
+// IBC denom it mints if it did not exist before. The first packet of a denom
+// is not rate limited: the rate limit needs the supply it creates.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, next func() ibcexported.Acknowledgement) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return next()
+	}
+
+	// tokens returning to this chain are unescrowed, not minted
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return next()
+	}
+
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom))
+	if k.transferKeeper.HasDenomTrace(ctx, trace.Hash()) {
+		return next()
+	}
+
+	ack := next()
+	if ack.Success() {
+		// keyed like the rate limits checked by the ratelimit middleware
+		k.ApplyDenomTemplate(ctx, ratelimitkeeper.ParseDenomFromRecvPacket(packet, data), trace.BaseDenom, packet.GetDestChannel())
+	}
+
+	return ack
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/outbe/outbe-node/x/ratepolicy/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// Template returns the template applied to a denom.
+func (k Querier) Template(c context.Context, req *types.QueryTemplateRequest) (*types.QueryTemplateResponse, error) {
+	template, found := k.GetParams(c).Template(req.Denom, req.Ibc)
+
+	return &types.QueryTemplateResponse{Template: template, Found: found}, nil
+}
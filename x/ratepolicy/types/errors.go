@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrInvalidRateLimit = errorsmod.Register(ModuleName, 2, "invalid rate limit")
+	ErrUnauthorized     = errorsmod.Register(ModuleName, 3, "signer is neither the admin nor governance")
+	ErrNotTighter       = errorsmod.Register(ModuleName, 4, "rate limit would be loosened")
+)
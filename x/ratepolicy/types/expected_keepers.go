@@ -0,0 +1,22 @@
+package types
+
+import (
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
+)
+
+// RateLimitKeeper defines the ratelimit methods used to create and tighten
+// rate limits.
+type RateLimitKeeper interface {
+	AddRateLimit(ctx sdk.Context, msg *ratelimittypes.MsgAddRateLimit) error
+	GetRateLimit(ctx sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool)
+	SetRateLimit(ctx sdk.Context, rateLimit ratelimittypes.RateLimit)
+}
+
+// TransferKeeper defines the transfer methods used to detect IBC denoms
+// received for the first time.
+type TransferKeeper interface {
+	HasDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) bool
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgTightenRateLimit{}
+)
+
+// NewMsgUpdateParams creates new instance of MsgUpdateParams
+func NewMsgUpdateParams(
+	sender sdk.Address,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: sender.String(),
+		Params:    params,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgTightenRateLimit creates new instance of MsgTightenRateLimit
+func NewMsgTightenRateLimit(signer sdk.Address, denom, channelID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgTightenRateLimit {
+	return &MsgTightenRateLimit{
+		Signer:         signer.String(),
+		Denom:          denom,
+		ChannelId:      channelID,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgTightenRateLimit) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgTightenRateLimit) Type() string { return "tighten_rate_limit" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgTightenRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgTightenRateLimit message.
+func (msg *MsgTightenRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data. Unlike the rate
+// limits added by governance, both quotas can be zero to halt a channel.
+func (msg *MsgTightenRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(err, "invalid signer address")
+	}
+
+	if msg.Denom == "" {
+		return errors.Wrap(ErrInvalidRateLimit, "empty denom")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return validatePercents(msg.MaxPercentSend, msg.MaxPercentRecv)
+}
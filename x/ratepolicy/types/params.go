@@ -0,0 +1,119 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AnyIBCDenom is the template denom matching every IBC denom without a
+// template of its own.
+const AnyIBCDenom = "*"
+
+// DefaultParams returns default module parameters, without templates or
+// admin.
+func DefaultParams() Params {
+	return Params{
+		Templates: []RateLimitTemplate{},
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(templates []RateLimitTemplate, admin string) Params {
+	return Params{
+		Templates: templates,
+		Admin:     admin,
+	}
+}
+
+// NewRateLimitTemplate creates a new RateLimitTemplate instance.
+func NewRateLimitTemplate(denom string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) RateLimitTemplate {
+	return RateLimitTemplate{
+		Denom:          denom,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.Templates))
+	for _, t := range p.Templates {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		if seen[t.Denom] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate template for %s", t.Denom)
+		}
+		seen[t.Denom] = true
+	}
+
+	if p.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid admin address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Template returns the template of denom, falling back to the AnyIBCDenom
+// template when ibc is set.
+func (p Params) Template(denom string, ibc bool) (RateLimitTemplate, bool) {
+	var wildcard *RateLimitTemplate
+	for i, t := range p.Templates {
+		switch t.Denom {
+		case denom:
+			return t, true
+		case AnyIBCDenom:
+			wildcard = &p.Templates[i]
+		}
+	}
+
+	if ibc && wildcard != nil {
+		return *wildcard, true
+	}
+
+	return RateLimitTemplate{}, false
+}
+
+// Validate checks the template has the same bounds as the rate limits added
+// by governance.
+func (t RateLimitTemplate) Validate() error {
+	if t.Denom == "" {
+		return errorsmod.Wrap(ErrInvalidParams, "empty template denom")
+	}
+
+	if err := sdk.ValidateDenom(t.Denom); err != nil && t.Denom != AnyIBCDenom {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid template denom: %s", err)
+	}
+
+	if err := validatePercents(t.MaxPercentSend, t.MaxPercentRecv); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "template %s: %s", t.Denom, err)
+	}
+
+	if t.MaxPercentSend.IsZero() && t.MaxPercentRecv.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidParams, "template %s halts both directions", t.Denom)
+	}
+
+	if t.DurationHours == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "template %s has a zero duration", t.Denom)
+	}
+
+	return nil
+}
+
+// validatePercents checks that both quotas are between 0 and 100.
+func validatePercents(maxPercentSend, maxPercentRecv sdkmath.Int) error {
+	hundred := sdkmath.NewInt(100)
+	for _, p := range []sdkmath.Int{maxPercentSend, maxPercentRecv} {
+		if p.IsNil() || p.IsNegative() || p.GT(hundred) {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "percent %s not between 0 and 100", p)
+		}
+	}
+
+	return nil
+}
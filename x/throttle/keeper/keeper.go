@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/throttle/types"
+)
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Usage is keyed by height, signer and message type, the latter empty for
+	// the usage of the signer.
+	Usage collections.Map[collections.Triple[int64, sdk.AccAddress, string], types.Usage]
+
+	// memory tracks the usage of txs entering the mempool of this node when
+	// limits are enforced in CheckTx only.
+	memory *memoryUsage
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	logger log.Logger,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Usage: collections.NewMap(sb, types.UsageKey, "usage",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.StringKey),
+			codec.CollValue[types.Usage](cdc),
+		),
+
+		memory: newMemoryUsage(),
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// Throttle checks the usage of each signer of a tx, in total and per message
+// type, against the limits over the window and records the tx. Exempt signers
+// and txs made of exempt message types only are not throttled.
+//
+// With MODE_ALL usage is read from and written to state, so executed txs are
+// throttled deterministically and txs entering the mempool see the usage of
+// the committed blocks. The tx is charged the gas_per_key param for each
+// signer and key instead of the gas of the store accesses. With MODE_CHECK_TX
+// usage is tracked in memory and only txs entering the mempool of this node
+// are throttled, for free; simulated txs are not.
+func (k Keeper) Throttle(ctx sdk.Context, signers []sdk.AccAddress, typeURLs []string, gasWanted uint64) error {
+	gasMeter := ctx.GasMeter()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	params := k.GetParams(ctx)
+
+	var (
+		store     usageStore
+		gasPerKey uint64
+	)
+	switch {
+	case params.Mode == types.MODE_ALL:
+		store = stateUsage{k.Usage}
+		gasPerKey = params.GasPerKey
+	case params.Mode == types.MODE_CHECK_TX && ctx.IsCheckTx() && ctx.ExecMode() != sdk.ExecModeSimulate:
+		k.memory.prune(ctx.BlockHeight() - int64(params.WindowBlocks))
+		store = k.memory
+	default:
+		return nil
+	}
+
+	typeURLs = unique(typeURLs)
+	if params.IsExemptTx(typeURLs) {
+		return nil
+	}
+
+	tx := types.Usage{Txs: 1, Gas: gasWanted}
+	height := ctx.BlockHeight()
+	from := height - int64(params.WindowBlocks) + 1
+
+	for _, signer := range signers {
+		if params.IsExemptAddress(signer.String()) {
+			continue
+		}
+
+		keys := []string{""}
+		limits := []types.Limit{params.SignerLimit}
+		for _, typeURL := range typeURLs {
+			if limit, ok := params.MsgTypeLimit(typeURL); ok {
+				keys = append(keys, typeURL)
+				limits = append(limits, limit)
+			}
+		}
+
+		for i, key := range keys {
+			gasMeter.ConsumeGas(gasPerKey, "throttle")
+
+			usage, err := sumUsage(ctx, store, from, height, signer, key)
+			if err != nil {
+				return err
+			}
+
+			if usage = usage.Add(tx); limits[i].Exceeds(usage) {
+				if key == "" {
+					return errorsmod.Wrapf(types.ErrThrottled, "signer %s would use %d txs and %d gas over %d blocks", signer, usage.Txs, usage.Gas, params.WindowBlocks)
+				}
+				return errorsmod.Wrapf(types.ErrThrottled, "signer %s would use %d %s txs and %d gas over %d blocks", signer, usage.Txs, key, usage.Gas, params.WindowBlocks)
+			}
+		}
+
+		for _, key := range keys {
+			if err := store.add(ctx, height, signer, key, tx); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSignerUsage returns the usage of a signer recorded in state over the
+// window ending at the current height, in total and per message type.
+func (k Keeper) GetSignerUsage(ctx sdk.Context, signer sdk.AccAddress) (types.Usage, []types.MsgTypeUsage, error) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	var (
+		total  types.Usage
+		byType = make(map[string]types.Usage)
+		order  []string
+	)
+	for h := height - int64(params.WindowBlocks) + 1; h <= height; h++ {
+		err := k.Usage.Walk(ctx, collections.NewSuperPrefixedTripleRange[int64, sdk.AccAddress, string](h, signer),
+			func(key collections.Triple[int64, sdk.AccAddress, string], u types.Usage) (bool, error) {
+				typeURL := key.K3()
+				if typeURL == "" {
+					total = total.Add(u)
+					return false, nil
+				}
+
+				if _, ok := byType[typeURL]; !ok {
+					order = append(order, typeURL)
+				}
+				byType[typeURL] = byType[typeURL].Add(u)
+				return false, nil
+			})
+		if err != nil {
+			return types.Usage{}, nil, err
+		}
+	}
+
+	msgTypes := make([]types.MsgTypeUsage, 0, len(order))
+	for _, typeURL := range order {
+		msgTypes = append(msgTypes, types.MsgTypeUsage{TypeUrl: typeURL, Usage: byType[typeURL]})
+	}
+
+	return total, msgTypes, nil
+}
+
+// PruneUsage deletes the usage recorded in state before the window ending at
+// the current height. Everything is deleted unless usage is recorded in state.
+func (k Keeper) PruneUsage(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	cutoff := height - int64(k.GetParams(ctx).EffectiveWindow())
+
+	return k.Usage.Clear(ctx, collections.NewPrefixUntilTripleRange[int64, sdk.AccAddress, string](cutoff))
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	for _, r := range data.Usage {
+		addr, err := sdk.AccAddressFromBech32(r.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Usage.Set(ctx, collections.Join3(r.Height, addr, r.TypeUrl), r.Usage); err != nil {
+			return err
+		}
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	var usage []types.UsageRecord
+	err := k.Usage.Walk(ctx, nil, func(key collections.Triple[int64, sdk.AccAddress, string], u types.Usage) (bool, error) {
+		usage = append(usage, types.UsageRecord{
+			Height:  key.K1(),
+			Address: key.K2().String(),
+			TypeUrl: key.K3(),
+			Usage:   u,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), usage...)
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/throttle/keeper"
+	"github.com/outbe/outbe-node/x/throttle/types"
+)
+
+const (
+	sendURL     = "/cosmos.bank.v1beta1.MsgSend"
+	delegateURL = "/cosmos.staking.v1beta1.MsgDelegate"
+	updateURL   = "/ibc.core.client.v1.MsgUpdateClient"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx.WithBlockHeight(10), k
+}
+
+func testParams(mode types.Mode, exempt ...string) types.Params {
+	return types.NewParams(
+		mode,
+		3,
+		types.Limit{MaxTxs: 3, MaxGas: 1_000_000},
+		[]types.MsgTypeLimit{{TypeUrl: delegateURL, Limit: types.Limit{MaxTxs: 1}}},
+		exempt,
+		[]string{updateURL},
+	)
+}
+
+func TestParamsValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("exempt_address______")).String()
+
+	for _, tc := range []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"all limits", testParams(types.MODE_ALL, addr), true},
+		{"unknown mode", types.NewParams(types.Mode(7), 1, types.Limit{}, nil, nil, nil), false},
+		{"empty window", types.NewParams(types.MODE_ALL, 0, types.Limit{}, nil, nil, nil), false},
+		{"window too large", types.NewParams(types.MODE_ALL, types.MaxWindowBlocks+1, types.Limit{}, nil, nil, nil), false},
+		{"invalid limit type", types.NewParams(types.MODE_ALL, 1, types.Limit{}, []types.MsgTypeLimit{{TypeUrl: "MsgSend"}}, nil, nil), false},
+		{"duplicate limit type", types.NewParams(types.MODE_ALL, 1, types.Limit{}, []types.MsgTypeLimit{{TypeUrl: sendURL}, {TypeUrl: sendURL}}, nil, nil), false},
+		{"invalid exempt address", types.NewParams(types.MODE_ALL, 1, types.Limit{}, nil, []string{"invalid"}, nil), false},
+		{"duplicate exempt address", types.NewParams(types.MODE_ALL, 1, types.Limit{}, nil, []string{addr, addr}, nil), false},
+		{"invalid exempt type", types.NewParams(types.MODE_ALL, 1, types.Limit{}, nil, nil, []string{"MsgSend"}), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer_address______"))
+	other := sdk.AccAddress([]byte("other_address_______"))
+	exempt := sdk.AccAddress([]byte("exempt_address______"))
+
+	for _, mode := range []types.Mode{types.MODE_ALL, types.MODE_CHECK_TX} {
+		t.Run(mode.String(), func(t *testing.T) {
+			ctx, k := setupKeeper(t)
+			ctx = ctx.WithIsCheckTx(true)
+			require.NoError(t, k.SetParams(ctx, testParams(mode, exempt.String())))
+
+			throttle := func(ctx sdk.Context, signer sdk.AccAddress, gas uint64, typeURLs ...string) error {
+				return k.Throttle(ctx, []sdk.AccAddress{signer}, typeURLs, gas)
+			}
+
+			// per message type limit
+			require.NoError(t, throttle(ctx, signer, 100, delegateURL, delegateURL))
+			require.ErrorIs(t, throttle(ctx, signer, 100, delegateURL), types.ErrThrottled)
+
+			// per signer limit, counting the rejected tx only once
+			require.NoError(t, throttle(ctx, signer, 100, sendURL))
+			require.NoError(t, throttle(ctx, signer, 100, sendURL))
+			require.ErrorIs(t, throttle(ctx, signer, 100, sendURL), types.ErrThrottled)
+
+			// gas limit
+			require.ErrorIs(t, throttle(ctx, other, 1_000_001, sendURL), types.ErrThrottled)
+			require.NoError(t, throttle(ctx, other, 1_000_000, sendURL))
+
+			// exempt signer and exempt tx
+			for i := 0; i < 5; i++ {
+				require.NoError(t, throttle(ctx, exempt, 100, delegateURL))
+				require.NoError(t, throttle(ctx, signer, 100, updateURL))
+			}
+			require.ErrorIs(t, throttle(ctx, signer, 100, updateURL, sendURL), types.ErrThrottled)
+
+			// still in the window two blocks later, out of it three blocks later
+			require.ErrorIs(t, throttle(ctx.WithBlockHeight(12), signer, 100, sendURL), types.ErrThrottled)
+			require.NoError(t, throttle(ctx.WithBlockHeight(13), signer, 100, sendURL))
+		})
+	}
+}
+
+func TestThrottleModes(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	signer := sdk.AccAddress([]byte("signer_address______"))
+	params := testParams(types.MODE_DISABLED)
+	params.SignerLimit = types.Limit{MaxTxs: 1}
+
+	throttle := func(ctx sdk.Context) error {
+		return k.Throttle(ctx, []sdk.AccAddress{signer}, []string{sendURL}, 100)
+	}
+
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, throttle(ctx))
+	require.NoError(t, throttle(ctx))
+
+	// CheckTx only, nothing is written to state
+	params.Mode = types.MODE_CHECK_TX
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, throttle(ctx))
+	require.NoError(t, throttle(ctx))
+	require.NoError(t, throttle(ctx.WithIsCheckTx(true)))
+	require.ErrorIs(t, throttle(ctx.WithIsCheckTx(true)), types.ErrThrottled)
+	// simulated txs are not throttled
+	require.NoError(t, throttle(ctx.WithIsCheckTx(true).WithExecMode(sdk.ExecModeSimulate)))
+	total, _, err := k.GetSignerUsage(ctx, signer)
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	// everywhere, recorded in state
+	params.Mode = types.MODE_ALL
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, throttle(ctx))
+	require.ErrorIs(t, throttle(ctx), types.ErrThrottled)
+
+	res, err := keeper.NewQuerier(k).SignerUsage(ctx, &types.QuerySignerUsageRequest{Address: signer.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.Usage{Txs: 1, Gas: 100}, res.Total)
+	require.Empty(t, res.MsgTypes)
+}
+
+func TestThrottleGas(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	signers := []sdk.AccAddress{sdk.AccAddress([]byte("signer_address______")), sdk.AccAddress([]byte("other_address_______"))}
+	params := testParams(types.MODE_ALL)
+	params.GasPerKey = 1_000
+
+	throttle := func(ctx sdk.Context, typeURLs ...string) uint64 {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+		require.NoError(t, k.Throttle(ctx, signers, typeURLs, 100))
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// a fixed gas per signer and key, whatever the store accesses
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, uint64(2*1_000), throttle(ctx, sendURL))
+	require.Equal(t, uint64(2*2*1_000), throttle(ctx, sendURL, delegateURL))
+	require.Zero(t, throttle(ctx, updateURL))
+
+	// nothing is charged for the usage tracked in memory
+	params.Mode = types.MODE_CHECK_TX
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Zero(t, throttle(ctx.WithIsCheckTx(true), sendURL))
+}
+
+func TestPruneUsage(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	signer := sdk.AccAddress([]byte("signer_address______"))
+	// track delegations without limiting them
+	params := testParams(types.MODE_ALL)
+	params.MsgTypeLimits[0].Limit = types.Limit{}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for h := int64(8); h <= 10; h++ {
+		require.NoError(t, k.Throttle(ctx.WithBlockHeight(h), []sdk.AccAddress{signer}, []string{delegateURL}, 100))
+	}
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, gs.Validate())
+	require.Len(t, gs.Usage, 6)
+
+	require.NoError(t, k.PruneUsage(ctx.WithBlockHeight(11)))
+	total, msgTypes, err := k.GetSignerUsage(ctx.WithBlockHeight(11), signer)
+	require.NoError(t, err)
+	require.Equal(t, types.Usage{Txs: 2, Gas: 200}, total)
+	require.Equal(t, []types.MsgTypeUsage{{TypeUrl: delegateURL, Usage: types.Usage{Txs: 2, Gas: 200}}}, msgTypes)
+	require.Len(t, k.ExportGenesis(ctx).Usage, 4)
+
+	// nothing is kept once usage is no longer recorded in state
+	require.NoError(t, k.SetParams(ctx, testParams(types.MODE_CHECK_TX)))
+	require.NoError(t, k.PruneUsage(ctx.WithBlockHeight(11)))
+	require.Empty(t, k.ExportGenesis(ctx).Usage)
+
+	// genesis round trip
+	ctx2, k2 := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, gs))
+	require.Equal(t, gs, k2.ExportGenesis(ctx2))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("signer_address______")).String()
+	record := types.UsageRecord{Height: 1, Address: addr, TypeUrl: sendURL, Usage: types.Usage{Txs: 1}}
+
+	require.NoError(t, types.DefaultGenesis().Validate())
+	require.NoError(t, types.NewGenesisState(types.DefaultParams(), record).Validate())
+	require.ErrorIs(t, types.NewGenesisState(types.DefaultParams(), record, record).Validate(), types.ErrInvalidUsage)
+	require.ErrorIs(t, types.NewGenesisState(types.DefaultParams(), types.UsageRecord{Address: "invalid"}).Validate(), types.ErrInvalidUsage)
+	require.ErrorIs(t, types.NewGenesisState(types.DefaultParams(), types.UsageRecord{Address: addr, TypeUrl: "MsgSend"}).Validate(), types.ErrInvalidUsage)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := testParams(types.MODE_ALL)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.Error(t, err)
+
+	invalid := params
+	invalid.WindowBlocks = 0
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"errors"
+	"sync"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/throttle/types"
+)
+
+// usageStore records the usage of signers per height.
+type usageStore interface {
+	get(ctx sdk.Context, height int64, signer sdk.AccAddress, typeURL string) (types.Usage, error)
+	add(ctx sdk.Context, height int64, signer sdk.AccAddress, typeURL string, usage types.Usage) error
+}
+
+// sumUsage sums the usage recorded from height from to height to, inclusive.
+func sumUsage(ctx sdk.Context, store usageStore, from, to int64, signer sdk.AccAddress, typeURL string) (types.Usage, error) {
+	var total types.Usage
+	for h := from; h <= to; h++ {
+		u, err := store.get(ctx, h, signer, typeURL)
+		if err != nil {
+			return types.Usage{}, err
+		}
+		total = total.Add(u)
+	}
+
+	return total, nil
+}
+
+// stateUsage records usage in the module store.
+type stateUsage struct {
+	usage collections.Map[collections.Triple[int64, sdk.AccAddress, string], types.Usage]
+}
+
+func (s stateUsage) get(ctx sdk.Context, height int64, signer sdk.AccAddress, typeURL string) (types.Usage, error) {
+	u, err := s.usage.Get(ctx, collections.Join3(height, signer, typeURL))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Usage{}, nil
+	}
+
+	return u, err
+}
+
+func (s stateUsage) add(ctx sdk.Context, height int64, signer sdk.AccAddress, typeURL string, usage types.Usage) error {
+	u, err := s.get(ctx, height, signer, typeURL)
+	if err != nil {
+		return err
+	}
+
+	return s.usage.Set(ctx, collections.Join3(height, signer, typeURL), u.Add(usage))
+}
+
+// memoryUsage records usage in memory. It is local to the node and must only
+// be used in CheckTx.
+type memoryUsage struct {
+	mu      sync.Mutex
+	heights map[int64]map[string]types.Usage
+}
+
+func newMemoryUsage() *memoryUsage {
+	return &memoryUsage{heights: make(map[int64]map[string]types.Usage)}
+}
+
+func memoryKey(signer sdk.AccAddress, typeURL string) string {
+	return string(signer) + "/" + typeURL
+}
+
+func (m *memoryUsage) get(_ sdk.Context, height int64, signer sdk.AccAddress, typeURL string) (types.Usage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.heights[height][memoryKey(signer, typeURL)], nil
+}
+
+func (m *memoryUsage) add(_ sdk.Context, height int64, signer sdk.AccAddress, typeURL string, usage types.Usage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.heights[height] == nil {
+		m.heights[height] = make(map[string]types.Usage)
+	}
+	key := memoryKey(signer, typeURL)
+	m.heights[height][key] = m.heights[height][key].Add(usage)
+
+	return nil
+}
+
+// prune deletes the usage recorded up to height cutoff.
+func (m *memoryUsage) prune(cutoff int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for h := range m.heights {
+		if h <= cutoff {
+			delete(m.heights, h)
+		}
+	}
+}
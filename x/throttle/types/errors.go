@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams = errorsmod.Register(ModuleName, 1, "invalid throttle params")
+	ErrInvalidUsage  = errorsmod.Register(ModuleName, 2, "invalid usage record")
+	ErrThrottled     = errorsmod.Register(ModuleName, 3, "tx throttled")
+)
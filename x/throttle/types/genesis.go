@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, usage ...UsageRecord) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Usage:  usage,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Usage))
+	for _, r := range gs.Usage {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidUsage, "invalid address %s: %s", r.Address, err)
+		}
+
+		if r.TypeUrl != "" {
+			if err := validateTypeURL(r.TypeUrl); err != nil {
+				return errorsmod.Wrap(ErrInvalidUsage, err.Error())
+			}
+		}
+
+		key := fmt.Sprintf("%d/%s/%s", r.Height, r.Address, r.TypeUrl)
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidUsage, "duplicate usage of %s at height %d", r.Address, r.Height)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
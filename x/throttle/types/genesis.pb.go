@@ -0,0 +1,1834 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: throttle/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Mode defines where the throttle limits are enforced.
+type Mode int32
+
+const (
+	// MODE_DISABLED turns throttling off.
+	MODE_DISABLED Mode = 0
+	// MODE_CHECK_TX enforces the limits on txs entering the mempool only. Usage
+	// is tracked in memory by each node and never written to state.
+	MODE_CHECK_TX Mode = 1
+	// MODE_ALL enforces the limits in CheckTx and when txs are executed. Usage
+	// of executed txs is written to state.
+	MODE_ALL Mode = 2
+)
+
+var Mode_name = map[int32]string{
+	0: "MODE_DISABLED",
+	1: "MODE_CHECK_TX",
+	2: "MODE_ALL",
+}
+
+var Mode_value = map[string]int32{
+	"MODE_DISABLED": 0,
+	"MODE_CHECK_TX": 1,
+	"MODE_ALL":      2,
+}
+
+func (x Mode) String() string {
+	return proto.EnumName(Mode_name, int32(x))
+}
+
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{0}
+}
+
+// GenesisState defines the throttle module genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// usage is the tx usage recorded in the current window.
+	Usage []UsageRecord `protobuf:"bytes,2,rep,name=usage,proto3" json:"usage"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetUsage() []UsageRecord {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// Params defines the throttle limits of the ante handler.
+type Params struct {
+	// mode defines where the limits are enforced.
+	Mode Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=throttle.v1.Mode" json:"mode,omitempty"`
+	// window_blocks is the number of blocks, including the current one, over
+	// which usage is summed.
+	WindowBlocks uint64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// signer_limit bounds the usage of each signer.
+	SignerLimit Limit `protobuf:"bytes,3,opt,name=signer_limit,json=signerLimit,proto3" json:"signer_limit"`
+	// msg_type_limits bound the usage of each signer per message type.
+	MsgTypeLimits []MsgTypeLimit `protobuf:"bytes,4,rep,name=msg_type_limits,json=msgTypeLimits,proto3" json:"msg_type_limits"`
+	// exempt_addresses are never throttled, e.g. IBC relayers.
+	ExemptAddresses []string `protobuf:"bytes,5,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// exempt_msg_types are message types of txs that are never throttled, e.g.
+	// IBC client updates. A tx is exempt only if all its messages are,
+	// including the ones nested in authz MsgExec and in proposals.
+	ExemptMsgTypes []string `protobuf:"bytes,6,rep,name=exempt_msg_types,json=exemptMsgTypes,proto3" json:"exempt_msg_types,omitempty"`
+	// gas_per_key is the gas charged to a tx for each signer and usage key
+	// checked with MODE_ALL, i.e. the usage of the signer and of each of its
+	// limited message types. It replaces the gas of the store accesses, whose
+	// number grows with the window.
+	GasPerKey uint64 `protobuf:"varint,7,opt,name=gas_per_key,json=gasPerKey,proto3" json:"gas_per_key,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMode() Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return MODE_DISABLED
+}
+
+func (m *Params) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSignerLimit() Limit {
+	if m != nil {
+		return m.SignerLimit
+	}
+	return Limit{}
+}
+
+func (m *Params) GetMsgTypeLimits() []MsgTypeLimit {
+	if m != nil {
+		return m.MsgTypeLimits
+	}
+	return nil
+}
+
+func (m *Params) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetExemptMsgTypes() []string {
+	if m != nil {
+		return m.ExemptMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetGasPerKey() uint64 {
+	if m != nil {
+		return m.GasPerKey
+	}
+	return 0
+}
+
+// Limit bounds the txs and the gas wanted in a window. Zero values are
+// unlimited.
+type Limit struct {
+	// max_txs is the maximum number of txs.
+	MaxTxs uint64 `protobuf:"varint,1,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	// max_gas is the maximum total gas wanted by the txs.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *Limit) Reset()         { *m = Limit{} }
+func (m *Limit) String() string { return proto.CompactTextString(m) }
+func (*Limit) ProtoMessage()    {}
+func (*Limit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{2}
+}
+func (m *Limit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Limit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Limit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Limit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Limit.Merge(m, src)
+}
+func (m *Limit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Limit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Limit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Limit proto.InternalMessageInfo
+
+func (m *Limit) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+func (m *Limit) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+// MsgTypeLimit bounds the usage of a message type.
+type MsgTypeLimit struct {
+	// type_url is the message type, e.g. /cosmos.bank.v1beta1.MsgSend.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Limit   Limit  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *MsgTypeLimit) Reset()         { *m = MsgTypeLimit{} }
+func (m *MsgTypeLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeLimit) ProtoMessage()    {}
+func (*MsgTypeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{3}
+}
+func (m *MsgTypeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeLimit.Merge(m, src)
+}
+func (m *MsgTypeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeLimit proto.InternalMessageInfo
+
+func (m *MsgTypeLimit) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeLimit) GetLimit() Limit {
+	if m != nil {
+		return m.Limit
+	}
+	return Limit{}
+}
+
+// Usage is the number of txs and the gas they wanted.
+type Usage struct {
+	Txs uint64 `protobuf:"varint,1,opt,name=txs,proto3" json:"txs,omitempty"`
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *Usage) Reset()         { *m = Usage{} }
+func (m *Usage) String() string { return proto.CompactTextString(m) }
+func (*Usage) ProtoMessage()    {}
+func (*Usage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{4}
+}
+func (m *Usage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Usage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Usage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Usage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Usage.Merge(m, src)
+}
+func (m *Usage) XXX_Size() int {
+	return m.Size()
+}
+func (m *Usage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Usage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Usage proto.InternalMessageInfo
+
+func (m *Usage) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *Usage) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// UsageRecord is the usage recorded for a signer, or a signer and message
+// type, at a height.
+type UsageRecord struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// address is the signer.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// type_url is the message type, empty for the usage of the signer.
+	TypeUrl string `protobuf:"bytes,3,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Usage   Usage  `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *UsageRecord) Reset()         { *m = UsageRecord{} }
+func (m *UsageRecord) String() string { return proto.CompactTextString(m) }
+func (*UsageRecord) ProtoMessage()    {}
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_720aaedadbda5b7b, []int{5}
+}
+func (m *UsageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageRecord.Merge(m, src)
+}
+func (m *UsageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageRecord proto.InternalMessageInfo
+
+func (m *UsageRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UsageRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *UsageRecord) GetUsage() Usage {
+	if m != nil {
+		return m.Usage
+	}
+	return Usage{}
+}
+
+func init() {
+	proto.RegisterEnum("throttle.v1.Mode", Mode_name, Mode_value)
+	proto.RegisterType((*GenesisState)(nil), "throttle.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "throttle.v1.Params")
+	proto.RegisterType((*Limit)(nil), "throttle.v1.Limit")
+	proto.RegisterType((*MsgTypeLimit)(nil), "throttle.v1.MsgTypeLimit")
+	proto.RegisterType((*Usage)(nil), "throttle.v1.Usage")
+	proto.RegisterType((*UsageRecord)(nil), "throttle.v1.UsageRecord")
+}
+
+func init() { proto.RegisterFile("throttle/v1/genesis.proto", fileDescriptor_720aaedadbda5b7b) }
+
+var fileDescriptor_720aaedadbda5b7b = []byte{
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0x12, 0x41,
+	0x1c, 0x65, 0xd9, 0x05, 0xca, 0x0f, 0xda, 0xd2, 0xb1, 0xd1, 0xa5, 0x87, 0x95, 0x60, 0x4c, 0x48,
+	0xb5, 0x6c, 0x4a, 0x13, 0x93, 0x7a, 0x2a, 0xb4, 0xb5, 0x9a, 0xd2, 0xd8, 0x6c, 0xdb, 0xc4, 0x78,
+	0x70, 0x33, 0xb0, 0x93, 0x61, 0x53, 0x96, 0x21, 0x3b, 0x43, 0x0b, 0x47, 0x6f, 0xea, 0xc9, 0xef,
+	0xe0, 0x45, 0x6f, 0x3d, 0xf8, 0x21, 0x7a, 0x6c, 0x3c, 0x79, 0x32, 0xa6, 0x3d, 0xf4, 0x6b, 0x98,
+	0x9d, 0x01, 0x0a, 0xc1, 0x18, 0x2f, 0x9b, 0x99, 0xf7, 0x7e, 0xef, 0xcd, 0xef, 0x1f, 0x40, 0x5e,
+	0xb4, 0x42, 0x26, 0x44, 0x9b, 0xd8, 0x67, 0xeb, 0x36, 0x25, 0x1d, 0xc2, 0x7d, 0x5e, 0xee, 0x86,
+	0x4c, 0x30, 0x94, 0x19, 0x51, 0xe5, 0xb3, 0xf5, 0x95, 0x65, 0xca, 0x28, 0x93, 0xb8, 0x1d, 0x9d,
+	0x54, 0xc8, 0xca, 0x12, 0x0e, 0xfc, 0x0e, 0xb3, 0xe5, 0x77, 0x08, 0xe5, 0x9b, 0x8c, 0x07, 0x8c,
+	0xbb, 0x2a, 0x56, 0x5d, 0x14, 0x55, 0x7c, 0xaf, 0x41, 0x76, 0x4f, 0x3d, 0x71, 0x24, 0xb0, 0x20,
+	0xe8, 0x19, 0x24, 0xbb, 0x38, 0xc4, 0x01, 0x37, 0xb5, 0x82, 0x56, 0xca, 0x54, 0xee, 0x95, 0x27,
+	0x9e, 0x2c, 0x1f, 0x4a, 0xaa, 0x96, 0xbe, 0xfc, 0xf5, 0x30, 0xf6, 0xf5, 0xf6, 0x62, 0x55, 0x73,
+	0x86, 0xd1, 0x68, 0x13, 0x12, 0x3d, 0x8e, 0x29, 0x31, 0xe3, 0x05, 0xbd, 0x94, 0xa9, 0x98, 0x53,
+	0xb2, 0x93, 0x88, 0x71, 0x48, 0x93, 0x85, 0xde, 0xa4, 0x56, 0x29, 0x8a, 0x1f, 0x75, 0x48, 0x2a,
+	0x63, 0xf4, 0x18, 0x8c, 0x80, 0x79, 0x44, 0xbe, 0xbd, 0x50, 0x59, 0x9a, 0x32, 0x39, 0x60, 0x1e,
+	0x71, 0x24, 0x8d, 0x1e, 0xc1, 0xfc, 0xb9, 0xdf, 0xf1, 0xd8, 0xb9, 0xdb, 0x68, 0xb3, 0xe6, 0x29,
+	0x37, 0xe3, 0x05, 0xad, 0x64, 0x38, 0x59, 0x05, 0xd6, 0x24, 0x86, 0xb6, 0x20, 0xcb, 0x7d, 0xda,
+	0x21, 0xa1, 0xdb, 0xf6, 0x03, 0x5f, 0x98, 0xba, 0xac, 0x07, 0x4d, 0x79, 0xd6, 0x23, 0x66, 0x32,
+	0xa5, 0x8c, 0x92, 0x48, 0x1c, 0xd5, 0x61, 0x31, 0xe0, 0xd4, 0x15, 0x83, 0x2e, 0x51, 0x1e, 0xdc,
+	0x34, 0x64, 0x75, 0xf9, 0xe9, 0xc4, 0x38, 0x3d, 0x1e, 0x74, 0xc9, 0x8c, 0xd7, 0x7c, 0x30, 0x41,
+	0x70, 0xb4, 0x0d, 0x39, 0xd2, 0x27, 0x41, 0x57, 0xb8, 0xd8, 0xf3, 0x42, 0xc2, 0x39, 0xe1, 0x66,
+	0xa2, 0xa0, 0x97, 0xd2, 0x35, 0xf3, 0xc7, 0xf7, 0xb5, 0xe5, 0xe1, 0x58, 0xaa, 0x8a, 0x3b, 0x12,
+	0xa1, 0xdf, 0xa1, 0xce, 0xa2, 0x52, 0x54, 0x47, 0x02, 0x54, 0x1a, 0x9b, 0x8c, 0x32, 0xe3, 0x66,
+	0x32, 0x32, 0x71, 0x16, 0x14, 0x3e, 0x4c, 0x86, 0x23, 0x0b, 0x32, 0x14, 0x73, 0xb7, 0x4b, 0x42,
+	0xf7, 0x94, 0x0c, 0xcc, 0x94, 0xec, 0x50, 0x9a, 0x62, 0x7e, 0x48, 0xc2, 0x7d, 0x32, 0x78, 0xbe,
+	0xfc, 0xe9, 0xf6, 0x62, 0x75, 0x71, 0xbc, 0x6a, 0x6a, 0x00, 0xc5, 0x4d, 0x48, 0xa8, 0xda, 0x1f,
+	0x40, 0x2a, 0xc0, 0x7d, 0x57, 0xf4, 0xd5, 0x22, 0x18, 0x4e, 0x32, 0xc0, 0xfd, 0xe3, 0x3e, 0x1f,
+	0x11, 0x14, 0x8f, 0xba, 0x1e, 0x11, 0x7b, 0x98, 0x17, 0xdf, 0x41, 0x76, 0xb2, 0x13, 0x28, 0x0f,
+	0x73, 0xb2, 0x73, 0xbd, 0xb0, 0x2d, 0x2d, 0xd2, 0x4e, 0x2a, 0xba, 0x9f, 0x84, 0x6d, 0xb4, 0x01,
+	0x09, 0x35, 0x93, 0xf8, 0xff, 0xcc, 0x44, 0xc5, 0x16, 0x9f, 0x40, 0x42, 0xee, 0x11, 0xca, 0x81,
+	0x7e, 0x97, 0x56, 0x74, 0x8c, 0x90, 0xbb, 0x7c, 0xa2, 0x63, 0xf1, 0x9b, 0x06, 0x99, 0x89, 0xad,
+	0x43, 0xf7, 0x21, 0xd9, 0x22, 0x3e, 0x6d, 0x09, 0x29, 0xd3, 0x9d, 0xe1, 0x0d, 0x55, 0x20, 0x35,
+	0x9c, 0x86, 0x54, 0xff, 0x6b, 0x16, 0xa3, 0xc0, 0xa9, 0xc2, 0xf4, 0x99, 0xc2, 0xd4, 0xaf, 0xc0,
+	0xf8, 0x4b, 0x61, 0x32, 0x9f, 0xd9, 0xfd, 0x5f, 0xdd, 0x02, 0x23, 0xda, 0x6d, 0xb4, 0x04, 0xf3,
+	0x07, 0xaf, 0x77, 0x76, 0xdd, 0x9d, 0x57, 0x47, 0xd5, 0x5a, 0x7d, 0x77, 0x27, 0x17, 0x1b, 0x43,
+	0xdb, 0x2f, 0x77, 0xb7, 0xf7, 0xdd, 0xe3, 0x37, 0x39, 0x0d, 0x65, 0x61, 0x4e, 0x42, 0xd5, 0x7a,
+	0x3d, 0x17, 0x5f, 0x31, 0x3e, 0x7c, 0xb1, 0x62, 0xb5, 0x17, 0x97, 0xd7, 0x96, 0x76, 0x75, 0x6d,
+	0x69, 0xbf, 0xaf, 0x2d, 0xed, 0xf3, 0x8d, 0x15, 0xbb, 0xba, 0xb1, 0x62, 0x3f, 0x6f, 0xac, 0xd8,
+	0xdb, 0xa7, 0xd4, 0x17, 0xad, 0x5e, 0xa3, 0xdc, 0x64, 0x81, 0xcd, 0x7a, 0xa2, 0x41, 0xd4, 0x77,
+	0xad, 0xc3, 0x3c, 0x62, 0xf7, 0xed, 0xf1, 0xf8, 0xe5, 0x26, 0x35, 0x92, 0xf2, 0x4f, 0x61, 0xe3,
+	0xcf, 0x00, 0x8a, 0x8a, 0x37, 0xe0, 0x82, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPerKey != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasPerKey))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExemptMsgTypes) > 0 {
+		for iNdEx := len(m.ExemptMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptMsgTypes[iNdEx])
+			copy(dAtA[i:], m.ExemptMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExemptMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgTypeLimits) > 0 {
+		for iNdEx := len(m.MsgTypeLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.SignerLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Mode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Limit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Limit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Limit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTxs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Usage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Usage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Usage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Txs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovGenesis(uint64(m.Mode))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowBlocks))
+	}
+	l = m.SignerLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MsgTypeLimits) > 0 {
+		for _, e := range m.MsgTypeLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExemptMsgTypes) > 0 {
+		for _, s := range m.ExemptMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GasPerKey != 0 {
+		n += 1 + sovGenesis(uint64(m.GasPerKey))
+	}
+	return n
+}
+
+func (m *Limit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTxs != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxs))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *MsgTypeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Usage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Txs != 0 {
+		n += 1 + sovGenesis(uint64(m.Txs))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovGenesis(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *UsageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, UsageRecord{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeLimits = append(m.MsgTypeLimits, MsgTypeLimit{})
+			if err := m.MsgTypeLimits[len(m.MsgTypeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptMsgTypes = append(m.ExemptMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerKey", wireType)
+			}
+			m.GasPerKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Limit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Limit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Limit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			m.MaxTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Usage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Usage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Usage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
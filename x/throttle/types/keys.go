@@ -0,0 +1,21 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// UsageKey saves the usage recorded per height, signer and message type.
+	UsageKey = collections.NewPrefix(1)
+)
+
+const (
+	ModuleName = "throttle"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultWindowBlocks is the default number of blocks usage is summed over.
+	DefaultWindowBlocks uint64 = 10
+
+	// MaxWindowBlocks bounds the window, as every throttled tx sums the usage
+	// of each block in it.
+	MaxWindowBlocks uint64 = 100
+
+	// DefaultGasPerKey is the default gas charged per signer and usage key,
+	// about the gas of the reads of a default window and of the write.
+	DefaultGasPerKey uint64 = 15_000
+)
+
+// DefaultParams returns default module parameters. Throttling is turned off
+// until enabled by governance.
+func DefaultParams() Params {
+	return Params{
+		Mode:            MODE_DISABLED,
+		WindowBlocks:    DefaultWindowBlocks,
+		MsgTypeLimits:   []MsgTypeLimit{},
+		ExemptAddresses: []string{},
+		ExemptMsgTypes:  []string{},
+		GasPerKey:       DefaultGasPerKey,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(mode Mode, windowBlocks uint64, signerLimit Limit, msgTypeLimits []MsgTypeLimit, exemptAddresses, exemptMsgTypes []string) Params {
+	return Params{
+		Mode:            mode,
+		WindowBlocks:    windowBlocks,
+		SignerLimit:     signerLimit,
+		MsgTypeLimits:   msgTypeLimits,
+		ExemptAddresses: exemptAddresses,
+		ExemptMsgTypes:  exemptMsgTypes,
+		GasPerKey:       DefaultGasPerKey,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if _, ok := Mode_name[int32(p.Mode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown mode %d", p.Mode)
+	}
+
+	if p.WindowBlocks == 0 || p.WindowBlocks > MaxWindowBlocks {
+		return errorsmod.Wrapf(ErrInvalidParams, "window of %d blocks must be between 1 and %d", p.WindowBlocks, MaxWindowBlocks)
+	}
+
+	seen := make(map[string]bool, len(p.MsgTypeLimits))
+	for _, l := range p.MsgTypeLimits {
+		if err := validateTypeURL(l.TypeUrl); err != nil {
+			return err
+		}
+		if seen[l.TypeUrl] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate limit for %s", l.TypeUrl)
+		}
+		seen[l.TypeUrl] = true
+	}
+
+	seen = make(map[string]bool, len(p.ExemptAddresses))
+	for _, addr := range p.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid exempt address %s: %s", addr, err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate exempt address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	seen = make(map[string]bool, len(p.ExemptMsgTypes))
+	for _, typeURL := range p.ExemptMsgTypes {
+		if err := validateTypeURL(typeURL); err != nil {
+			return err
+		}
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate exempt message type %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+func validateTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") {
+		return errorsmod.Wrapf(ErrInvalidParams, "message type %q must start with /", typeURL)
+	}
+
+	return nil
+}
+
+// EffectiveWindow returns the number of blocks usage must be kept in state
+// for, zero unless usage is recorded in state.
+func (p Params) EffectiveWindow() uint64 {
+	if p.Mode != MODE_ALL {
+		return 0
+	}
+
+	return p.WindowBlocks
+}
+
+// IsExemptAddress reports whether txs signed by addr are never throttled.
+func (p Params) IsExemptAddress(addr string) bool {
+	for _, a := range p.ExemptAddresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExemptTx reports whether all message types of a tx are exempt.
+func (p Params) IsExemptTx(typeURLs []string) bool {
+	if len(typeURLs) == 0 {
+		return false
+	}
+
+	for _, typeURL := range typeURLs {
+		exempt := false
+		for _, t := range p.ExemptMsgTypes {
+			if t == typeURL {
+				exempt = true
+				break
+			}
+		}
+		if !exempt {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MsgTypeLimit returns the limit of a message type, if any.
+func (p Params) MsgTypeLimit(typeURL string) (Limit, bool) {
+	for _, l := range p.MsgTypeLimits {
+		if l.TypeUrl == typeURL {
+			return l.Limit, true
+		}
+	}
+
+	return Limit{}, false
+}
+
+// Exceeds reports whether usage is over the limit.
+func (l Limit) Exceeds(u Usage) bool {
+	return (l.MaxTxs != 0 && u.Txs > l.MaxTxs) || (l.MaxGas != 0 && u.Gas > l.MaxGas)
+}
+
+// IsUnlimited reports whether the limit bounds nothing.
+func (l Limit) IsUnlimited() bool {
+	return l.MaxTxs == 0 && l.MaxGas == 0
+}
+
+// Add returns the sum of two usages.
+func (u Usage) Add(o Usage) Usage {
+	return Usage{Txs: u.Txs + o.Txs, Gas: u.Gas + o.Gas}
+}
@@ -0,0 +1,51 @@
+package tokenhooks
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "tokenhooks.v1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tokenhooks parameters",
+				},
+				{
+					RpcMethod:      "BeforeSendHook",
+					Use:            "before-send-hook [denom]",
+					Short:          "Query the before-send hook contract of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "BeforeSendHooks",
+					Use:       "before-send-hooks",
+					Short:     "Query all registered before-send hooks",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "tokenhooks.v1.Msg",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // set by governance
+				},
+				{
+					RpcMethod: "SetBeforeSendHook",
+					Use:       "set-before-send-hook [denom] [contract-address]",
+					Short:     "Set the before-send hook contract of a tokenfactory denom you administer",
+					Long:      "Set the before-send hook contract of a tokenfactory denom you administer. Pass an empty contract address to remove the hook.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "contract_address"},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/outbe/outbe-node/x/tokenhooks/types"
+)
+
+// BlockBeforeSendMsg is the sudo message sent to hook contracts. It follows
+// the Osmosis tokenfactory format so existing hook contracts work unchanged.
+type BlockBeforeSendMsg struct {
+	BlockBeforeSend BlockBeforeSend `json:"block_before_send"`
+}
+
+// BlockBeforeSend describes the transfer being checked.
+type BlockBeforeSend struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
+
+// BeforeSendRestriction is a bank send restriction that calls the hook
+// contract of every transferred denom. A hook returning an error rejects the
+// transfer. Transfers from or to a module account, such as mints, burns, IBC
+// escrows and fee payments, skip the hooks so a hook cannot halt a module.
+func (k Keeper) BeforeSendRestriction(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if k.isModuleAccount(ctx, from) || k.isModuleAccount(ctx, to) {
+		return to, nil
+	}
+
+	for _, coin := range amt {
+		contract := k.GetBeforeSendHook(ctx, coin.Denom)
+		if contract == "" {
+			continue
+		}
+
+		if err := k.callBeforeSendHook(sdk.UnwrapSDKContext(ctx), contract, from, to, coin); err != nil {
+			return to, err
+		}
+	}
+
+	return to, nil
+}
+
+func (k Keeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// callBeforeSendHook sudo calls the hook contract with a gas meter capped at
+// the gas_limit param. The gas used is charged to the parent context.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, contract string, from, to sdk.AccAddress, coin sdk.Coin) (err error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(BlockBeforeSendMsg{
+		BlockBeforeSend: BlockBeforeSend{From: from.String(), To: to.String(), Amount: coin},
+	})
+	if err != nil {
+		return err
+	}
+
+	limit := k.GetParams(ctx).GasLimit
+	hookCtx := ctx.WithGasMeter(storetypes.NewGasMeter(limit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(hookCtx.GasMeter().GasConsumedToLimit(), "tokenhooks before-send")
+
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(types.ErrHookOutOfGas, "denom %s, limit %d", coin.Denom, limit)
+		}
+	}()
+
+	if _, err := k.contractKeeper.Sudo(hookCtx, contractAddr, msg); err != nil {
+		return errorsmod.Wrapf(types.ErrSendBlocked, "denom %s: %s", coin.Denom, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/outbe/outbe-node/x/tokenhooks/types"
+)
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	logger log.Logger
+
+	// state management
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	Hooks  collections.Map[string, string]
+
+	accountKeeper      types.AccountKeeper
+	tokenFactoryKeeper types.TokenFactoryKeeper
+	contractKeeper     types.ContractKeeper
+	contractViewKeeper types.ContractViewKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	accountKeeper types.AccountKeeper,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	contractKeeper types.ContractKeeper,
+	contractViewKeeper types.ContractViewKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Hooks:  collections.NewMap(sb, types.HooksKey, "hooks", collections.StringKey, collections.StringValue),
+
+		accountKeeper:      accountKeeper,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		contractKeeper:     contractKeeper,
+		contractViewKeeper: contractViewKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// GetBeforeSendHook returns the hook contract of denom, or an empty string if
+// none is registered.
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+	contract, err := k.Hooks.Get(ctx, denom)
+	if err != nil {
+		return ""
+	}
+
+	return contract
+}
+
+// GetAllBeforeSendHooks returns every registered hook ordered by denom.
+func (k Keeper) GetAllBeforeSendHooks(ctx context.Context) ([]types.BeforeSendHook, error) {
+	var hooks []types.BeforeSendHook
+	err := k.Hooks.Walk(ctx, nil, func(denom, contract string) (bool, error) {
+		hooks = append(hooks, types.BeforeSendHook{Denom: denom, ContractAddress: contract})
+		return false, nil
+	})
+
+	return hooks, err
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	for _, h := range data.Hooks {
+		if err := k.Hooks.Set(ctx, h.Denom, h.ContractAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	hooks, err := k.GetAllBeforeSendHooks(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), hooks...)
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/tokenhooks/keeper"
+	"github.com/outbe/outbe-node/x/tokenhooks/types"
+)
+
+var (
+	admin    = sdk.AccAddress([]byte("admin_address_______"))
+	other    = sdk.AccAddress([]byte("other_address_______"))
+	contract = sdk.AccAddress([]byte("hook_contract_______"))
+	module   = authtypes.NewModuleAddress("module")
+	denom    = "factory/" + admin.String() + "/token"
+)
+
+type mockTokenFactory struct{}
+
+func (mockTokenFactory) GetAuthorityMetadata(_ context.Context, d string) (tokenfactorytypes.DenomAuthorityMetadata, error) {
+	if d != denom {
+		return tokenfactorytypes.DenomAuthorityMetadata{}, errors.New("unknown denom")
+	}
+
+	return tokenfactorytypes.DenomAuthorityMetadata{Admin: admin.String()}, nil
+}
+
+// mockAccounts knows the module account only.
+type mockAccounts struct{}
+
+func (mockAccounts) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	if !addr.Equals(module) {
+		return nil
+	}
+
+	return authtypes.NewEmptyModuleAccount("module")
+}
+
+// mockContract records sudo calls, rejects transfers to other and burns gas
+// when asked to.
+type mockContract struct {
+	calls   []keeper.BlockBeforeSendMsg
+	gasUsed uint64
+}
+
+func (m *mockContract) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var call keeper.BlockBeforeSendMsg
+	if err := json.Unmarshal(msg, &call); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, call)
+
+	ctx.GasMeter().ConsumeGas(m.gasUsed, "hook")
+	if call.BlockBeforeSend.To == other.String() {
+		return nil, errors.New("recipient not allowed")
+	}
+
+	return nil, nil
+}
+
+func (m *mockContract) HasContractInfo(_ context.Context, addr sdk.AccAddress) bool {
+	return addr.Equals(contract)
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockContract) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	wasm := &mockContract{}
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		mockAccounts{},
+		mockTokenFactory{},
+		wasm,
+		wasm,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), k, wasm
+}
+
+func TestSetBeforeSendHook(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(other, denom, contract.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin, denom, other.String()))
+	require.ErrorIs(t, err, types.ErrContractMissing)
+
+	_, err = ms.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin, denom, contract.String()))
+	require.NoError(t, err)
+	require.Equal(t, contract.String(), k.GetBeforeSendHook(ctx, denom))
+
+	res, err := keeper.NewQuerier(k).BeforeSendHooks(ctx, &types.QueryBeforeSendHooksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BeforeSendHook{{Denom: denom, ContractAddress: contract.String()}}, res.Hooks)
+
+	_, err = ms.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin, denom, ""))
+	require.NoError(t, err)
+	require.Empty(t, k.GetBeforeSendHook(ctx, denom))
+}
+
+func TestBeforeSendRestriction(t *testing.T) {
+	ctx, k, wasm := setupKeeper(t)
+	require.NoError(t, k.Hooks.Set(ctx, denom, contract.String()))
+
+	hooked := sdk.NewInt64Coin(denom, 10)
+	plain := sdk.NewInt64Coin("unit", 10)
+
+	// denoms without a hook are not checked
+	_, err := k.BeforeSendRestriction(ctx, admin, other, sdk.NewCoins(plain))
+	require.NoError(t, err)
+	require.Empty(t, wasm.calls)
+
+	to, err := k.BeforeSendRestriction(ctx, other, admin, sdk.NewCoins(hooked, plain))
+	require.NoError(t, err)
+	require.Equal(t, admin, to)
+	require.Len(t, wasm.calls, 1)
+	require.Equal(t, keeper.BlockBeforeSend{From: other.String(), To: admin.String(), Amount: hooked}, wasm.calls[0].BlockBeforeSend)
+
+	_, err = k.BeforeSendRestriction(ctx, admin, other, sdk.NewCoins(hooked))
+	require.ErrorIs(t, err, types.ErrSendBlocked)
+
+	// transfers from or to module accounts skip the hooks
+	for _, addrs := range [][2]sdk.AccAddress{{module, other}, {admin, module}} {
+		_, err = k.BeforeSendRestriction(ctx, addrs[0], addrs[1], sdk.NewCoins(hooked))
+		require.NoError(t, err)
+	}
+	require.Len(t, wasm.calls, 2)
+
+	// gas used by the hook is charged to the caller
+	gasOf := func() uint64 {
+		before := ctx.GasMeter().GasConsumed()
+		_, err := k.BeforeSendRestriction(ctx, other, admin, sdk.NewCoins(hooked))
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed() - before
+	}
+	base := gasOf()
+	wasm.gasUsed = 1_000
+	require.Equal(t, base+1_000, gasOf())
+
+	// hooks cannot exceed the gas limit param
+	require.NoError(t, k.SetParams(ctx, types.NewParams(500)))
+	_, err = k.BeforeSendRestriction(ctx, other, admin, sdk.NewCoins(hooked))
+	require.ErrorIs(t, err, types.ErrHookOutOfGas)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	gs := types.NewGenesisState(types.NewParams(100_000), types.BeforeSendHook{Denom: denom, ContractAddress: contract.String()})
+	require.NoError(t, k.InitGenesis(ctx, gs))
+	require.Equal(t, gs, k.ExportGenesis(ctx))
+
+	dup := types.NewGenesisState(types.DefaultParams(), gs.Hooks[0], gs.Hooks[0])
+	require.ErrorIs(t, dup.Validate(), types.ErrInvalidHook)
+}
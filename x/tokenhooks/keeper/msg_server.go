@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/tokenhooks/types"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the module MsgServer interface.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+// UpdateParams replaces the module params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, msg.Authority)
+	}
+
+	if err := ms.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetBeforeSendHook registers or removes the hook contract of a denom.
+func (ms msgServer) SetBeforeSendHook(ctx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	authority, err := ms.k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authority.Admin == "" || authority.Admin != msg.Sender {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "denom %s", msg.Denom)
+	}
+
+	if msg.ContractAddress == "" {
+		if err := ms.k.Hooks.Remove(ctx, msg.Denom); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSetBeforeSendHookResponse{}, nil
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ms.k.contractViewKeeper.HasContractInfo(ctx, contractAddr) {
+		return nil, errors.Wrap(types.ErrContractMissing, msg.ContractAddress)
+	}
+
+	if err := ms.k.Hooks.Set(ctx, msg.Denom, msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/outbe/outbe-node/x/tokenhooks/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// BeforeSendHook returns the hook contract registered for a denom.
+func (k Querier) BeforeSendHook(c context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	return &types.QueryBeforeSendHookResponse{ContractAddress: k.GetBeforeSendHook(c, req.Denom)}, nil
+}
+
+// BeforeSendHooks returns all registered hooks.
+func (k Querier) BeforeSendHooks(c context.Context, _ *types.QueryBeforeSendHooksRequest) (*types.QueryBeforeSendHooksResponse, error) {
+	hooks, err := k.GetAllBeforeSendHooks(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBeforeSendHooksResponse{Hooks: hooks}, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidParams   = errorsmod.Register(ModuleName, 1, "invalid params")
+	ErrInvalidHook     = errorsmod.Register(ModuleName, 2, "invalid before-send hook")
+	ErrUnauthorized    = errorsmod.Register(ModuleName, 3, "sender is not the denom admin")
+	ErrSendBlocked     = errorsmod.Register(ModuleName, 4, "transfer rejected by before-send hook")
+	ErrHookOutOfGas    = errorsmod.Register(ModuleName, 5, "before-send hook ran out of gas")
+	ErrContractMissing = errorsmod.Register(ModuleName, 6, "hook contract does not exist")
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+
+	tokenfactorytypes "github.com/strangelove-ventures/tokenfactory/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the auth methods used to find the module accounts
+// exempt from hooks.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// TokenFactoryKeeper defines the tokenfactory methods used to authorize hook
+// registration.
+type TokenFactoryKeeper interface {
+	GetAuthorityMetadata(ctx context.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+}
+
+// ContractKeeper defines the wasm methods used to call hook contracts.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractViewKeeper defines the wasm methods used to validate hook contracts.
+type ContractViewKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
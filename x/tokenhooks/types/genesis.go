@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, hooks ...BeforeSendHook) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Hooks:  hooks,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Hooks))
+	for _, h := range gs.Hooks {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+
+		if seen[h.Denom] {
+			return errorsmod.Wrapf(ErrInvalidHook, "duplicate hook for denom %s", h.Denom)
+		}
+		seen[h.Denom] = true
+	}
+
+	return nil
+}
+
+// Validate checks the denom and contract address of the hook.
+func (h BeforeSendHook) Validate() error {
+	if err := sdk.ValidateDenom(h.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidHook, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidHook, "invalid contract address: %s", err)
+	}
+
+	return nil
+}
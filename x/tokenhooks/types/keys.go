@@ -0,0 +1,21 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// HooksKey saves the hook contract of each denom.
+	HooksKey = collections.NewPrefix(1)
+)
+
+const (
+	ModuleName = "tokenhooks"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
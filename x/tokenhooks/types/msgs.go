@@ -0,0 +1,89 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetBeforeSendHook{}
+)
+
+// NewMsgUpdateParams creates new instance of MsgUpdateParams
+func NewMsgUpdateParams(
+	sender sdk.Address,
+	params Params,
+) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: sender.String(),
+		Params:    params,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgUpdateParams) Type() string { return "update_params" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgSetBeforeSendHook creates new instance of MsgSetBeforeSendHook
+func NewMsgSetBeforeSendHook(sender sdk.Address, denom, contractAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender.String(),
+		Denom:           denom,
+		ContractAddress: contractAddress,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetBeforeSendHook) Route() string { return ModuleName }
+
+// Type returns the the action
+func (msg MsgSetBeforeSendHook) Type() string { return "set_before_send_hook" }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetBeforeSendHook message.
+func (msg *MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetBeforeSendHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(err, "invalid sender address")
+	}
+
+	// an empty contract address removes the hook
+	if msg.ContractAddress == "" {
+		return errors.Wrap(sdk.ValidateDenom(msg.Denom), "invalid denom")
+	}
+
+	return BeforeSendHook{Denom: msg.Denom, ContractAddress: msg.ContractAddress}.Validate()
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGasLimit is the default gas budget of a single before-send hook call.
+const DefaultGasLimit uint64 = 500_000
+
+// DefaultParams returns default module parameters.
+func DefaultParams() Params {
+	return Params{
+		GasLimit: DefaultGasLimit,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(gasLimit uint64) Params {
+	return Params{
+		GasLimit: gasLimit,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "gas limit must be positive")
+	}
+
+	return nil
+}
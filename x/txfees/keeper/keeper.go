@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/outbe/outbe-node/x/txfees/types"
+)
+
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	logger log.Logger
+
+	// state management
+	Schema       collections.Schema
+	Params       collections.Item[types.Params]
+	AccountStats collections.Map[sdk.AccAddress, types.AccountStats]
+
+	bankKeeper types.BankKeeper
+
+	authority string
+}
+
+// NewKeeper creates a new Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	logger log.Logger,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	if authority == "" {
+		panic("authority must be set")
+	}
+
+	k := Keeper{
+		cdc:    cdc,
+		logger: logger,
+
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountStats: collections.NewMap(sb, types.AccountStatsKey, "account_stats", sdk.AccAddressKey, codec.CollValue[types.AccountStats](cdc)),
+
+		bankKeeper: bankKeeper,
+
+		authority: authority,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+
+	return k
+}
+
+func (k Keeper) Logger() log.Logger {
+	return k.logger
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module params, falling back to the defaults
+// when none are stored yet.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	p, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return p
+}
+
+// SetParams validates and stores the module params.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, p)
+}
+
+// GetAccountStats returns the recorded totals of an account, zero if nothing
+// was recorded.
+func (k Keeper) GetAccountStats(ctx context.Context, addr sdk.AccAddress) (types.AccountStats, error) {
+	stats, err := k.AccountStats.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AccountStats{Address: addr.String(), FeesPaid: sdk.NewCoins()}, nil
+	}
+
+	return stats, err
+}
+
+// ProcessTx runs after a successful tx paid by payer. Depending on the params
+// it refunds part of the fee for the unused gas from the fee collector,
+// records the tx and the fee net of the refund for the payer, and emits an
+// EventTxFees.
+func (k Keeper) ProcessTx(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, gasWanted, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if !params.IsActive() {
+		return nil
+	}
+
+	refund := sdk.NewCoins()
+	if params.RefundEnabled {
+		refund = types.ComputeRefund(fee, gasWanted, gasUsed, params.RefundRatio)
+		if !refund.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer, refund); err != nil {
+				return err
+			}
+		}
+	}
+
+	if params.AccountingEnabled {
+		stats, err := k.GetAccountStats(ctx, payer)
+		if err != nil {
+			return err
+		}
+
+		stats.TxCount++
+		stats.FeesPaid = stats.FeesPaid.Add(fee.Sub(refund...)...)
+		if err := k.AccountStats.Set(ctx, payer, stats); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTxFees{
+		Payer:     payer.String(),
+		Fee:       fee,
+		Refund:    refund,
+		GasWanted: gasWanted,
+		GasUsed:   gasUsed,
+	})
+}
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	for _, s := range data.AccountStats {
+		addr, err := sdk.AccAddressFromBech32(s.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.AccountStats.Set(ctx, addr, s); err != nil {
+			return err
+		}
+	}
+
+	return k.Params.Set(ctx, data.Params)
+}
+
+// ExportGenesis exports the module's state to a genesis state.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	var stats []types.AccountStats
+	err := k.AccountStats.Walk(ctx, nil, func(_ sdk.AccAddress, s types.AccountStats) (bool, error) {
+		stats = append(stats, s)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), stats...)
+}
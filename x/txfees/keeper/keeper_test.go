@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/outbe/outbe-node/x/txfees/keeper"
+	"github.com/outbe/outbe-node/x/txfees/types"
+)
+
+type mockBankKeeper struct {
+	sent map[string]sdk.Coins
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule != authtypes.FeeCollectorName {
+		panic("unexpected sender " + senderModule)
+	}
+	b.sent[recipientAddr.String()] = b.sent[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockBankKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	bank := &mockBankKeeper{sent: map[string]sdk.Coins{}}
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		log.NewNopLogger(),
+		bank,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return testCtx.Ctx, k, bank
+}
+
+func TestComputeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("unit", 1000), sdk.NewInt64Coin("uatom", 7))
+	half := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	for _, tc := range []struct {
+		name      string
+		gasWanted uint64
+		gasUsed   uint64
+		ratio     sdkmath.LegacyDec
+		refund    sdk.Coins
+	}{
+		{"all gas used", 100, 100, half, sdk.NewCoins()},
+		{"zero gas wanted", 0, 0, half, sdk.NewCoins()},
+		{"zero ratio", 100, 0, sdkmath.LegacyZeroDec(), sdk.NewCoins()},
+		{"half unused, half refunded", 100, 50, half, sdk.NewCoins(sdk.NewInt64Coin("unit", 250), sdk.NewInt64Coin("uatom", 1))},
+		{"nothing used, all refunded", 100, 0, sdkmath.LegacyOneDec(), fee},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.refund, types.ComputeRefund(fee, tc.gasWanted, tc.gasUsed, tc.ratio))
+		})
+	}
+}
+
+func TestProcessTx(t *testing.T) {
+	ctx, k, bank := setupKeeper(t)
+
+	payer := sdk.AccAddress([]byte("payer_address_______"))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("unit", 1000))
+
+	// disabled by default
+	require.NoError(t, k.ProcessTx(ctx, payer, fee, 100, 50))
+	require.Empty(t, bank.sent)
+	require.Empty(t, ctx.EventManager().Events())
+
+	require.NoError(t, k.SetParams(ctx, types.NewParams(true, sdkmath.LegacyNewDecWithPrec(5, 1), true)))
+	require.NoError(t, k.ProcessTx(ctx, payer, fee, 100, 50))
+	require.NoError(t, k.ProcessTx(ctx, payer, fee, 100, 100))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unit", 250)), bank.sent[payer.String()])
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	stats, err := k.GetAccountStats(ctx, payer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TxCount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unit", 1750)), stats.FeesPaid)
+
+	q := keeper.NewQuerier(k)
+	res, err := q.AccountStats(ctx, &types.QueryAccountStatsRequest{Address: payer.String()})
+	require.NoError(t, err)
+	require.Equal(t, stats, res.Stats)
+
+	all, err := q.AllAccountStats(ctx, &types.QueryAllAccountStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.AccountStats{stats}, all.Stats)
+
+	// accounting only
+	require.NoError(t, k.SetParams(ctx, types.NewParams(false, sdkmath.LegacyOneDec(), true)))
+	require.NoError(t, k.ProcessTx(ctx, payer, fee, 100, 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unit", 250)), bank.sent[payer.String()])
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, gs.Validate())
+	require.Len(t, gs.AccountStats, 1)
+	require.Equal(t, uint64(3), gs.AccountStats[0].TxCount)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(true, sdkmath.LegacyNewDecWithPrec(8, 1), true)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.Error(t, err)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(true, sdkmath.LegacyNewDec(2), false),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRefundRatio)
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/outbe/outbe-node/x/txfees/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params returns the module params.
+func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(c)}, nil
+}
+
+// AccountStats returns the recorded totals of an account.
+func (k Querier) AccountStats(c context.Context, req *types.QueryAccountStatsRequest) (*types.QueryAccountStatsResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := k.GetAccountStats(c, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountStatsResponse{Stats: stats}, nil
+}
+
+// AllAccountStats returns the recorded totals of all accounts.
+func (k Querier) AllAccountStats(c context.Context, req *types.QueryAllAccountStatsRequest) (*types.QueryAllAccountStatsResponse, error) {
+	stats, pageRes, err := query.CollectionPaginate(c, k.Keeper.AccountStats, req.Pagination,
+		func(_ sdk.AccAddress, s types.AccountStats) (types.AccountStats, error) {
+			return s, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAccountStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
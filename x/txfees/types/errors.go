@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidRefundRatio  = errorsmod.Register(ModuleName, 1, "invalid refund ratio")
+	ErrInvalidAccountStats = errorsmod.Register(ModuleName, 2, "invalid account stats")
+)
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: txfees/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventTxFees is emitted by the post handler for every successful tx.
+type EventTxFees struct {
+	// payer is the account that paid the fee, the fee granter if set.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// fee is the fee deducted by the ante handler.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// refund is the part of the fee returned to the payer.
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+	// gas_wanted is the gas limit of the tx.
+	GasWanted uint64 `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the tx before the post handler.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *EventTxFees) Reset()         { *m = EventTxFees{} }
+func (m *EventTxFees) String() string { return proto.CompactTextString(m) }
+func (*EventTxFees) ProtoMessage()    {}
+func (*EventTxFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a62c23828fb1b72e, []int{0}
+}
+func (m *EventTxFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTxFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTxFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTxFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTxFees.Merge(m, src)
+}
+func (m *EventTxFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTxFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTxFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTxFees proto.InternalMessageInfo
+
+func (m *EventTxFees) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *EventTxFees) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *EventTxFees) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+func (m *EventTxFees) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *EventTxFees) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventTxFees)(nil), "txfees.v1.EventTxFees")
+}
+
+func init() { proto.RegisterFile("txfees/v1/events.proto", fileDescriptor_a62c23828fb1b72e) }
+
+var fileDescriptor_a62c23828fb1b72e = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x51, 0x4d, 0x4e, 0xf3, 0x30,
+	0x14, 0x4c, 0xfa, 0xf7, 0x7d, 0x75, 0x77, 0x51, 0x85, 0xd2, 0x4a, 0xa4, 0x15, 0xab, 0x08, 0xa9,
+	0x31, 0x81, 0x13, 0x50, 0x7e, 0x0e, 0x50, 0x40, 0x48, 0x48, 0xa8, 0x4a, 0xe2, 0x57, 0x13, 0xa1,
+	0xda, 0x55, 0x9e, 0x13, 0xda, 0x5b, 0x70, 0x0e, 0xd6, 0xec, 0xb8, 0x40, 0x97, 0x15, 0x2b, 0x56,
+	0x80, 0xda, 0x8b, 0xa0, 0xc4, 0x5e, 0x70, 0x00, 0x36, 0xb6, 0xdf, 0xcc, 0x78, 0x66, 0xa4, 0x47,
+	0xf6, 0xd4, 0x72, 0x06, 0x80, 0xb4, 0x08, 0x29, 0x14, 0x20, 0x14, 0x06, 0x8b, 0x4c, 0x2a, 0xe9,
+	0xb4, 0x35, 0x1e, 0x14, 0x61, 0xbf, 0xcb, 0x25, 0x97, 0x15, 0x4a, 0xcb, 0x97, 0x16, 0xf4, 0x7b,
+	0x89, 0xc4, 0xb9, 0xc4, 0xa9, 0x26, 0xf4, 0x60, 0x28, 0x4f, 0x4f, 0x34, 0x8e, 0x10, 0x68, 0x11,
+	0xc6, 0xa0, 0xa2, 0x90, 0x26, 0x32, 0x15, 0x9a, 0x3f, 0x78, 0xab, 0x91, 0xce, 0x45, 0x19, 0x76,
+	0xbd, 0xbc, 0x04, 0x40, 0x27, 0x20, 0xcd, 0x45, 0xb4, 0x82, 0xcc, 0xb5, 0x87, 0xb6, 0xdf, 0x1e,
+	0xbb, 0xef, 0xaf, 0xa3, 0xae, 0x31, 0x3c, 0x65, 0x2c, 0x03, 0xc4, 0x2b, 0x95, 0xa5, 0x82, 0x4f,
+	0xb4, 0xcc, 0xb9, 0x27, 0xf5, 0x19, 0x80, 0x5b, 0x1b, 0xd6, 0xfd, 0xce, 0x71, 0x2f, 0x30, 0xd2,
+	0x32, 0x2d, 0x30, 0x69, 0xc1, 0x99, 0x4c, 0xc5, 0xf8, 0x68, 0xfd, 0x39, 0xb0, 0x5e, 0xbe, 0x06,
+	0x3e, 0x4f, 0xd5, 0x43, 0x1e, 0x07, 0x89, 0x9c, 0x9b, 0xa2, 0xe6, 0x1a, 0x21, 0x7b, 0xa4, 0x6a,
+	0xb5, 0x00, 0xac, 0x3e, 0xe0, 0xa4, 0xf4, 0x75, 0x12, 0xd2, 0xca, 0x60, 0x96, 0x0b, 0xe6, 0xd6,
+	0xff, 0x3e, 0xc1, 0x58, 0x3b, 0xfb, 0x84, 0xf0, 0x08, 0xa7, 0x4f, 0x91, 0x50, 0xc0, 0xdc, 0xc6,
+	0xd0, 0xf6, 0x1b, 0x93, 0x36, 0x8f, 0xf0, 0xb6, 0x02, 0x9c, 0x1e, 0xf9, 0x5f, 0xd2, 0x39, 0x02,
+	0x73, 0x9b, 0x15, 0xf9, 0x8f, 0x47, 0x78, 0x83, 0xc0, 0xc6, 0xe7, 0xeb, 0xad, 0x67, 0x6f, 0xb6,
+	0x9e, 0xfd, 0xbd, 0xf5, 0xec, 0xe7, 0x9d, 0x67, 0x6d, 0x76, 0x9e, 0xf5, 0xb1, 0xf3, 0xac, 0xbb,
+	0xc3, 0x5f, 0x2d, 0x64, 0xae, 0x62, 0xd0, 0xe7, 0x48, 0x48, 0x06, 0x74, 0x49, 0xcd, 0xa6, 0xab,
+	0x36, 0x71, 0xab, 0x5a, 0xc5, 0xc9, 0xcf, 0x00, 0x4d, 0x25, 0x67, 0xfb, 0x00, 0x02, 0x00, 0x00,
+}
+
+func (m *EventTxFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTxFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTxFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTxFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovEvents(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTxFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTxFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTxFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, stats ...AccountStats) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		AccountStats: stats,
+	}
+}
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.AccountStats))
+	for _, s := range gs.AccountStats {
+		if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAccountStats, "invalid address %s: %s", s.Address, err)
+		}
+
+		if seen[s.Address] {
+			return errorsmod.Wrapf(ErrInvalidAccountStats, "duplicate address %s", s.Address)
+		}
+		seen[s.Address] = true
+
+		if err := s.FeesPaid.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAccountStats, "invalid fees for %s: %s", s.Address, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,933 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: txfees/v1/genesis.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the txfees module genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// account_stats are the recorded per account totals.
+	AccountStats []AccountStats `protobuf:"bytes,2,rep,name=account_stats,json=accountStats,proto3" json:"account_stats"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691333886db79dd7, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetAccountStats() []AccountStats {
+	if m != nil {
+		return m.AccountStats
+	}
+	return nil
+}
+
+// Params defines what the post handler does after a tx executed.
+type Params struct {
+	// refund_enabled turns on refunds of fees paid for unused gas.
+	RefundEnabled bool `protobuf:"varint,1,opt,name=refund_enabled,json=refundEnabled,proto3" json:"refund_enabled,omitempty"`
+	// refund_ratio is the fraction of the fee paid for unused gas that is
+	// refunded, between 0 and 1.
+	RefundRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=refund_ratio,json=refundRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"refund_ratio"`
+	// accounting_enabled turns on recording of per account tx counts and fees.
+	AccountingEnabled bool `protobuf:"varint,3,opt,name=accounting_enabled,json=accountingEnabled,proto3" json:"accounting_enabled,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691333886db79dd7, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRefundEnabled() bool {
+	if m != nil {
+		return m.RefundEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAccountingEnabled() bool {
+	if m != nil {
+		return m.AccountingEnabled
+	}
+	return false
+}
+
+// AccountStats are the totals recorded for a fee payer.
+type AccountStats struct {
+	// address is the fee payer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// tx_count is the number of successful txs the account paid fees for.
+	TxCount uint64 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// fees_paid are the fees paid net of refunds.
+	FeesPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_paid,json=feesPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_paid"`
+}
+
+func (m *AccountStats) Reset()         { *m = AccountStats{} }
+func (m *AccountStats) String() string { return proto.CompactTextString(m) }
+func (*AccountStats) ProtoMessage()    {}
+func (*AccountStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691333886db79dd7, []int{2}
+}
+func (m *AccountStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStats.Merge(m, src)
+}
+func (m *AccountStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStats proto.InternalMessageInfo
+
+func (m *AccountStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *AccountStats) GetFeesPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "txfees.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "txfees.v1.Params")
+	proto.RegisterType((*AccountStats)(nil), "txfees.v1.AccountStats")
+}
+
+func init() { proto.RegisterFile("txfees/v1/genesis.proto", fileDescriptor_691333886db79dd7) }
+
+var fileDescriptor_691333886db79dd7 = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xcd, 0x6a, 0x1b, 0x31,
+	0x10, 0xb6, 0x92, 0xe2, 0xd8, 0xb2, 0x5d, 0xb0, 0x08, 0xc4, 0x4e, 0x61, 0x6d, 0x0c, 0x05, 0x63,
+	0xb0, 0x84, 0xdd, 0x9f, 0x43, 0x6f, 0x71, 0x5c, 0x72, 0xe9, 0x21, 0x6c, 0x4e, 0xed, 0x65, 0xd1,
+	0xee, 0x2a, 0x1b, 0x91, 0xae, 0x64, 0x56, 0xb2, 0x71, 0x5e, 0xa1, 0x50, 0xe8, 0x63, 0x94, 0x9e,
+	0x72, 0xc8, 0x43, 0xe4, 0xd0, 0x42, 0xc8, 0xa9, 0xf4, 0x90, 0x16, 0xfb, 0x90, 0xd7, 0x28, 0xfa,
+	0x69, 0xea, 0x5c, 0xb4, 0x3b, 0xf3, 0x8d, 0xbe, 0xf9, 0xe6, 0x1b, 0xc1, 0x3d, 0xbd, 0x3c, 0x65,
+	0x4c, 0x91, 0xc5, 0x88, 0x64, 0x4c, 0x30, 0xc5, 0x15, 0x9e, 0x15, 0x52, 0x4b, 0x54, 0x75, 0x00,
+	0x5e, 0x8c, 0xf6, 0x77, 0x33, 0x99, 0x49, 0x9b, 0x25, 0xe6, 0xcf, 0x15, 0xec, 0x37, 0x69, 0xce,
+	0x85, 0x24, 0xf6, 0xf4, 0xa9, 0x76, 0x22, 0x55, 0x2e, 0x55, 0xe4, 0x6a, 0x5d, 0xe0, 0xa1, 0xc0,
+	0x45, 0x24, 0xa6, 0x8a, 0x91, 0xc5, 0x28, 0x66, 0x9a, 0x8e, 0x48, 0x22, 0xb9, 0x70, 0x78, 0xef,
+	0x33, 0x80, 0xf5, 0x23, 0x27, 0xe0, 0x44, 0x53, 0xcd, 0xd0, 0x4b, 0x58, 0x9e, 0xd1, 0x82, 0xe6,
+	0xaa, 0x05, 0xba, 0xa0, 0x5f, 0x1b, 0x37, 0xf1, 0x83, 0x20, 0x7c, 0x6c, 0x81, 0x49, 0xf5, 0xfa,
+	0xae, 0x53, 0xfa, 0x7a, 0x7f, 0x39, 0x00, 0xa1, 0xaf, 0x45, 0x47, 0xb0, 0x41, 0x93, 0x44, 0xce,
+	0x85, 0x8e, 0x94, 0xa6, 0x5a, 0xb5, 0xb6, 0xba, 0xdb, 0xfd, 0xda, 0x78, 0x6f, 0xe3, 0xf2, 0x81,
+	0xc3, 0x4d, 0x97, 0x47, 0x14, 0x75, 0xba, 0x01, 0xf4, 0x7e, 0x00, 0x58, 0x76, 0x6d, 0xd0, 0x73,
+	0xf8, 0xb4, 0x60, 0xa7, 0x73, 0x91, 0x46, 0x4c, 0xd0, 0xf8, 0x23, 0x4b, 0xad, 0xa2, 0x4a, 0xd8,
+	0x70, 0xd9, 0xb7, 0x2e, 0x89, 0xde, 0xc3, 0xba, 0x2f, 0x2b, 0xa8, 0xe6, 0xb2, 0xb5, 0xd5, 0x05,
+	0xfd, 0xea, 0xe4, 0xb5, 0x69, 0xf0, 0xeb, 0xae, 0xf3, 0xcc, 0xcd, 0xaf, 0xd2, 0x73, 0xcc, 0x25,
+	0xc9, 0xa9, 0x3e, 0xc3, 0xef, 0x58, 0x46, 0x93, 0x8b, 0x29, 0x4b, 0x6e, 0xaf, 0x86, 0xd0, 0x9b,
+	0x35, 0x65, 0x89, 0x53, 0x53, 0x73, 0x5c, 0xa1, 0xa1, 0x42, 0x43, 0x88, 0xbc, 0x38, 0x2e, 0xb2,
+	0x07, 0x15, 0xdb, 0x56, 0x45, 0xf3, 0x3f, 0xe2, 0x95, 0xbc, 0x41, 0x9f, 0xee, 0x2f, 0x07, 0x0d,
+	0xbf, 0x58, 0x37, 0x44, 0xef, 0x3b, 0x80, 0xf5, 0xcd, 0xc9, 0xd1, 0x18, 0xee, 0xd0, 0x34, 0x2d,
+	0x98, 0x72, 0x06, 0x57, 0x27, 0xad, 0xdb, 0xab, 0xe1, 0xae, 0x97, 0x71, 0xe0, 0x90, 0x13, 0x5d,
+	0x70, 0x91, 0x85, 0xff, 0x0a, 0x51, 0x1b, 0x56, 0xf4, 0x32, 0xb2, 0x24, 0x76, 0xbc, 0x27, 0xe1,
+	0x8e, 0x5e, 0x1e, 0x9a, 0x10, 0xe5, 0xb0, 0x6a, 0xda, 0x45, 0x33, 0xca, 0x8d, 0x32, 0x63, 0x7a,
+	0x1b, 0x7b, 0x36, 0xb3, 0x73, 0xec, 0x77, 0x8e, 0x0f, 0x25, 0x17, 0x93, 0x57, 0xc6, 0x95, 0x6f,
+	0xbf, 0x3b, 0xfd, 0x8c, 0xeb, 0xb3, 0x79, 0x8c, 0x13, 0x99, 0xfb, 0xe7, 0xe2, 0x3f, 0x43, 0x95,
+	0x9e, 0x13, 0x7d, 0x31, 0x63, 0xca, 0x5e, 0x50, 0xce, 0x94, 0x8a, 0x69, 0x71, 0x4c, 0x79, 0x3a,
+	0x99, 0x5e, 0xaf, 0x02, 0x70, 0xb3, 0x0a, 0xc0, 0x9f, 0x55, 0x00, 0xbe, 0xac, 0x83, 0xd2, 0xcd,
+	0x3a, 0x28, 0xfd, 0x5c, 0x07, 0xa5, 0x0f, 0x83, 0x0d, 0x4a, 0x39, 0xd7, 0x31, 0x73, 0xe7, 0x50,
+	0xc8, 0x94, 0x91, 0x25, 0xf1, 0xae, 0x58, 0xea, 0xb8, 0x6c, 0xdf, 0xde, 0x8b, 0xbf, 0x03, 0x00,
+	0xb1, 0xd7, 0xef, 0xd4, 0x05, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountStats) > 0 {
+		for iNdEx := len(m.AccountStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountingEnabled {
+		i--
+		if m.AccountingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.RefundRatio.Size()
+		i -= size
+		if _, err := m.RefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RefundEnabled {
+		i--
+		if m.RefundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountStats) > 0 {
+		for _, e := range m.AccountStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefundEnabled {
+		n += 2
+	}
+	l = m.RefundRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.AccountingEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *AccountStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TxCount))
+	}
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountStats = append(m.AccountStats, AccountStats{})
+			if err := m.AccountStats[len(m.AccountStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccountingEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, types.Coin{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
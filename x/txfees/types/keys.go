@@ -0,0 +1,21 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+var (
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+
+	// AccountStatsKey saves the recorded totals of each fee payer.
+	AccountStatsKey = collections.NewPrefix(1)
+)
+
+const (
+	ModuleName = "txfees"
+
+	StoreKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRefundRatio is the default fraction of the fee for unused gas that is
+// refunded once refunds are enabled.
+var DefaultRefundRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+
+// DefaultParams returns default module parameters. Refunds and accounting are
+// turned off until enabled by governance.
+func DefaultParams() Params {
+	return Params{
+		RefundEnabled:     false,
+		RefundRatio:       DefaultRefundRatio,
+		AccountingEnabled: false,
+	}
+}
+
+// NewParams creates a new Params instance.
+func NewParams(refundEnabled bool, refundRatio sdkmath.LegacyDec, accountingEnabled bool) Params {
+	return Params{
+		RefundEnabled:     refundEnabled,
+		RefundRatio:       refundRatio,
+		AccountingEnabled: accountingEnabled,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.RefundRatio.IsNil() || p.RefundRatio.IsNegative() || p.RefundRatio.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidRefundRatio, "%s must be between 0 and 1", p.RefundRatio)
+	}
+
+	return nil
+}
+
+// IsActive reports whether the post handler has anything to do.
+func (p Params) IsActive() bool {
+	return p.RefundEnabled || p.AccountingEnabled
+}
+
+// ComputeRefund returns the part of fee refunded for the unused gas:
+// floor(fee * ratio * (gasWanted - gasUsed) / gasWanted) for each coin.
+func ComputeRefund(fee sdk.Coins, gasWanted, gasUsed uint64, ratio sdkmath.LegacyDec) sdk.Coins {
+	if gasWanted == 0 || gasUsed >= gasWanted || !ratio.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	unused := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasWanted - gasUsed))
+	fraction := ratio.Mul(unused).Quo(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasWanted)))
+
+	refund := sdk.NewCoins()
+	for _, c := range fee {
+		refund = refund.Add(sdk.NewCoin(c.Denom, sdkmath.LegacyNewDecFromInt(c.Amount).Mul(fraction).TruncateInt()))
+	}
+
+	return refund
+}